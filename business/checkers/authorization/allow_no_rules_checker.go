@@ -0,0 +1,27 @@
+package authorization
+
+import (
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+type AllowNoRulesChecker struct {
+	AuthorizationPolicy kubernetes.IstioObject
+}
+
+// Check returns a warning when an ALLOW policy has no rules. Such a policy matches no request,
+// so it denies all the traffic to the selected workloads.
+func (a AllowNoRulesChecker) Check() ([]*models.IstioCheck, bool) {
+	checks := make([]*models.IstioCheck, 0)
+
+	if action, found := a.AuthorizationPolicy.GetSpec()["action"]; found && action != "ALLOW" {
+		return checks, true
+	}
+
+	if rules, ok := a.AuthorizationPolicy.GetSpec()["rules"].([]interface{}); !ok || len(rules) == 0 {
+		check := models.Build("authorizationpolicy.allow.norules", "spec/rules")
+		checks = append(checks, &check)
+	}
+
+	return checks, true
+}
@@ -0,0 +1,66 @@
+package authorization
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestAllowNoRules(t *testing.T) {
+	assert := assert.New(t)
+
+	ap := allowNoRulesPolicy()
+	ap.GetSpec()["action"] = "ALLOW"
+	ap.GetSpec()["rules"] = []interface{}{}
+
+	vals, valid := AllowNoRulesChecker{AuthorizationPolicy: ap}.Check()
+
+	assert.True(valid)
+	assert.Len(vals, 1)
+	assert.Equal(models.WarningSeverity, vals[0].Severity)
+	assert.Equal("spec/rules", vals[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("authorizationpolicy.allow.norules", vals[0]))
+
+	// ALLOW is the default action
+	delete(ap.GetSpec(), "action")
+	delete(ap.GetSpec(), "rules")
+
+	vals, valid = AllowNoRulesChecker{AuthorizationPolicy: ap}.Check()
+
+	assert.True(valid)
+	assert.Len(vals, 1)
+}
+
+func TestAllowOneRule(t *testing.T) {
+	assert := assert.New(t)
+
+	vals, valid := AllowNoRulesChecker{AuthorizationPolicy: data.CreateAuthorizationPolicy([]interface{}{"bookinfo"}, []interface{}{"GET"}, []interface{}{"productpage"},
+		map[string]interface{}{"app": "productpage"})}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestDenyNoRules(t *testing.T) {
+	assert := assert.New(t)
+
+	ap := allowNoRulesPolicy()
+	ap.GetSpec()["action"] = "DENY"
+
+	vals, valid := AllowNoRulesChecker{AuthorizationPolicy: ap}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func allowNoRulesPolicy() kubernetes.IstioObject {
+	ap := data.CreateAuthorizationPolicy([]interface{}{"bookinfo"}, []interface{}{"GET"}, []interface{}{"productpage"},
+		map[string]interface{}{"app": "productpage"})
+	delete(ap.GetSpec(), "rules")
+	return ap
+}
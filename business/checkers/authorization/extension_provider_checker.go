@@ -0,0 +1,41 @@
+package authorization
+
+import (
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+type ExtensionProviderChecker struct {
+	AuthorizationPolicy kubernetes.IstioObject
+	ExtensionProviders  []string
+}
+
+// Check returns an error when a CUSTOM AuthorizationPolicy references a provider
+// that isn't defined in the mesh config extensionProviders. Nothing is checked when the providers are unknown.
+func (e ExtensionProviderChecker) Check() ([]*models.IstioCheck, bool) {
+	validations := make([]*models.IstioCheck, 0)
+
+	if e.ExtensionProviders == nil {
+		return validations, true
+	}
+
+	if action, ok := e.AuthorizationPolicy.GetSpec()["action"].(string); !ok || action != "CUSTOM" {
+		return validations, true
+	}
+
+	providerName := ""
+	if provider, ok := e.AuthorizationPolicy.GetSpec()["provider"].(map[string]interface{}); ok {
+		providerName, _ = provider["name"].(string)
+	}
+
+	for _, extensionProvider := range e.ExtensionProviders {
+		if extensionProvider == providerName {
+			return validations, true
+		}
+	}
+
+	validation := models.Build("authorizationpolicy.custom.providernotfound", "spec/provider/name")
+	validations = append(validations, &validation)
+
+	return validations, false
+}
@@ -0,0 +1,69 @@
+package authorization
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestCustomPolicyDefinedProvider(t *testing.T) {
+	assert := assert.New(t)
+
+	vals, valid := ExtensionProviderChecker{
+		AuthorizationPolicy: customPolicy("sample-ext-authz-grpc"),
+		ExtensionProviders:  []string{"sample-ext-authz-http", "sample-ext-authz-grpc"},
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestCustomPolicyUndefinedProvider(t *testing.T) {
+	assert := assert.New(t)
+
+	vals, valid := ExtensionProviderChecker{
+		AuthorizationPolicy: customPolicy("sample-ext-authz-grcp"),
+		ExtensionProviders:  []string{"sample-ext-authz-http", "sample-ext-authz-grpc"},
+	}.Check()
+
+	assert.False(valid)
+	assert.Len(vals, 1)
+	assert.Equal(models.ErrorSeverity, vals[0].Severity)
+	assert.Equal("spec/provider/name", vals[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("authorizationpolicy.custom.providernotfound", vals[0]))
+}
+
+func TestAllowPolicyWithoutProvider(t *testing.T) {
+	assert := assert.New(t)
+
+	vals, valid := ExtensionProviderChecker{
+		AuthorizationPolicy: data.CreateAuthorizationPolicy([]interface{}{"bookinfo"}, []interface{}{"GET"}, []interface{}{"reviews"}, map[string]interface{}{"app": "reviews"}),
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func customPolicy(provider string) kubernetes.IstioObject {
+	ap := data.CreateAuthorizationPolicy([]interface{}{"bookinfo"}, []interface{}{"GET"}, []interface{}{"reviews"}, map[string]interface{}{"app": "reviews"})
+	ap.GetSpec()["action"] = "CUSTOM"
+	ap.GetSpec()["provider"] = map[string]interface{}{"name": provider}
+	return ap
+}
+
+func TestCustomPolicyUnknownProviders(t *testing.T) {
+	assert := assert.New(t)
+
+	// The mesh config couldn't be read
+	vals, valid := ExtensionProviderChecker{
+		AuthorizationPolicy: customPolicy("sample-ext-authz-grpc"),
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
@@ -0,0 +1,75 @@
+package authorization
+
+import (
+	"fmt"
+
+	"github.com/kiali/kiali/business/checkers/common"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+// ingressGatewayLabels are the labels set by the Istio installation on the ingress gateway workloads
+var ingressGatewayLabels = map[string]string{
+	"istio":                       "ingressgateway",
+	"app":                         "istio-ingressgateway",
+	"operator.istio.io/component": "IngressGateways",
+}
+
+type IngressGatewayChecker struct {
+	AuthorizationPolicy kubernetes.IstioObject
+}
+
+// Check returns an informational check for each allow-all rule of an ALLOW policy selecting the ingress gateway.
+// A rule without from, to and when sections matches every request, so the policy doesn't restrict the edge traffic.
+func (ig IngressGatewayChecker) Check() ([]*models.IstioCheck, bool) {
+	checks := make([]*models.IstioCheck, 0)
+
+	if !ig.isAllowPolicy() || !ig.selectsIngressGateway() {
+		return checks, true
+	}
+
+	rules, ok := ig.AuthorizationPolicy.GetSpec()["rules"].([]interface{})
+	if !ok {
+		return checks, true
+	}
+
+	for ruleIdx, r := range rules {
+		rule, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if isAllowAllRule(rule) {
+			check := models.Build("authorizationpolicy.ingressgateway.allowall", fmt.Sprintf("spec/rules[%d]", ruleIdx))
+			checks = append(checks, &check)
+		}
+	}
+
+	return checks, true
+}
+
+func (ig IngressGatewayChecker) isAllowPolicy() bool {
+	action, found := ig.AuthorizationPolicy.GetSpec()["action"]
+	// ALLOW is the default action
+	return !found || action == "ALLOW"
+}
+
+func (ig IngressGatewayChecker) selectsIngressGateway() bool {
+	selectorLabels := common.GetSelectorLabels(ig.AuthorizationPolicy)
+	for k, v := range ingressGatewayLabels {
+		if selectorLabels[k] == v {
+			return true
+		}
+	}
+	return false
+}
+
+func isAllowAllRule(rule map[string]interface{}) bool {
+	for _, section := range []string{"from", "to", "when"} {
+		if value, found := rule[section]; found {
+			if values, ok := value.([]interface{}); !ok || len(values) > 0 {
+				return false
+			}
+		}
+	}
+	return true
+}
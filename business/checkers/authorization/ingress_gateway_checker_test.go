@@ -0,0 +1,73 @@
+package authorization
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestIngressGatewayAllowAllRule(t *testing.T) {
+	assert := assert.New(t)
+
+	ap := ingressGatewayPolicy(map[string]interface{}{"istio": "ingressgateway"})
+	ap.GetSpec()["rules"] = []interface{}{
+		map[string]interface{}{},
+	}
+
+	vals, valid := IngressGatewayChecker{AuthorizationPolicy: ap}.Check()
+
+	assert.True(valid)
+	assert.Len(vals, 1)
+	assert.Equal(models.InfoSeverity, vals[0].Severity)
+	assert.Equal("spec/rules[0]", vals[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("authorizationpolicy.ingressgateway.allowall", vals[0]))
+}
+
+func TestIngressGatewayScopedRule(t *testing.T) {
+	assert := assert.New(t)
+
+	ap := ingressGatewayPolicy(map[string]interface{}{"istio": "ingressgateway"})
+
+	vals, valid := IngressGatewayChecker{AuthorizationPolicy: ap}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestIngressGatewayDenyAllowAllRule(t *testing.T) {
+	assert := assert.New(t)
+
+	ap := ingressGatewayPolicy(map[string]interface{}{"istio": "ingressgateway"})
+	ap.GetSpec()["action"] = "DENY"
+	ap.GetSpec()["rules"] = []interface{}{
+		map[string]interface{}{},
+	}
+
+	vals, valid := IngressGatewayChecker{AuthorizationPolicy: ap}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestNonIngressGatewayAllowAllRule(t *testing.T) {
+	assert := assert.New(t)
+
+	ap := ingressGatewayPolicy(map[string]interface{}{"app": "productpage"})
+	ap.GetSpec()["rules"] = []interface{}{
+		map[string]interface{}{},
+	}
+
+	vals, valid := IngressGatewayChecker{AuthorizationPolicy: ap}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func ingressGatewayPolicy(selector map[string]interface{}) kubernetes.IstioObject {
+	return data.CreateAuthorizationPolicy([]interface{}{"bookinfo"}, []interface{}{"GET"}, []interface{}{"productpage"}, selector)
+}
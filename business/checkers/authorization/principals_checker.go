@@ -0,0 +1,100 @@
+package authorization
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+type PrincipalsChecker struct {
+	AuthorizationPolicy kubernetes.IstioObject
+	// Namespaces holds the namespaces accessible by Kiali
+	Namespaces models.NamespaceNames
+	// ServiceAccounts optionally holds the known service accounts per namespace.
+	// Service accounts are only verified for the namespaces present in it.
+	ServiceAccounts map[string][]string
+}
+
+// Check returns a warning for each source principal, in the <trust-domain>/ns/<namespace>/sa/<service-account> form,
+// referencing a namespace or a service account that doesn't exist. Principals using wildcards are accepted.
+func (pc PrincipalsChecker) Check() ([]*models.IstioCheck, bool) {
+	checks := make([]*models.IstioCheck, 0)
+
+	rules, ok := pc.AuthorizationPolicy.GetSpec()["rules"].([]interface{})
+	if !ok {
+		return checks, true
+	}
+
+	for ruleIdx, rule := range rules {
+		ruleMap, ok := rule.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		fromList, ok := ruleMap["from"].([]interface{})
+		if !ok {
+			continue
+		}
+		for fromIdx, from := range fromList {
+			fromMap, ok := from.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			sourceMap, ok := fromMap["source"].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			principals, ok := sourceMap["principals"].([]interface{})
+			if !ok {
+				continue
+			}
+			for i, principal := range principals {
+				principalStr, ok := principal.(string)
+				if !ok || pc.principalExists(principalStr) {
+					continue
+				}
+				path := fmt.Sprintf("spec/rules[%d]/from[%d]/source/principals[%d]", ruleIdx, fromIdx, i)
+				validation := models.Build("authorizationpolicy.source.principalnotfound", path)
+				checks = append(checks, &validation)
+			}
+		}
+	}
+
+	return checks, true
+}
+
+func (pc PrincipalsChecker) principalExists(principal string) bool {
+	parts := strings.Split(principal, "/")
+	if len(parts) != 5 || parts[1] != "ns" || parts[3] != "sa" {
+		// Wildcards (i.e. "*" or "*/sa/foo") and other formats can't be verified
+		return true
+	}
+
+	namespace, serviceAccount := parts[2], parts[4]
+	if strings.Contains(namespace, "*") {
+		return true
+	}
+	if !pc.Namespaces.Includes(namespace) {
+		// A namespace Kiali can't access may still exist, unless Kiali can access all of them
+		return !allNamespacesAccessible()
+	}
+
+	serviceAccounts, found := pc.ServiceAccounts[namespace]
+	if !found || strings.Contains(serviceAccount, "*") {
+		return true
+	}
+	for _, sa := range serviceAccounts {
+		if sa == serviceAccount {
+			return true
+		}
+	}
+	return false
+}
+
+// allNamespacesAccessible determines if Kiali can access every namespace of the cluster
+func allNamespacesAccessible() bool {
+	an := config.Get().Deployment.AccessibleNamespaces
+	return len(an) == 1 && an[0] == "**"
+}
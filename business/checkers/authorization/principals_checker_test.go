@@ -0,0 +1,103 @@
+package authorization
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestValidPrincipal(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := PrincipalsChecker{
+		AuthorizationPolicy: principalsPolicy([]interface{}{"cluster.local/ns/bookinfo/sa/bookinfo-productpage"}),
+		Namespaces:          []string{"bookinfo"},
+		ServiceAccounts:     map[string][]string{"bookinfo": {"bookinfo-productpage"}},
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestUnknownNamespacePrincipal(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := PrincipalsChecker{
+		AuthorizationPolicy: principalsPolicy([]interface{}{
+			"cluster.local/ns/bookinfo/sa/bookinfo-productpage",
+			"cluster.local/ns/wrong/sa/bookinfo-productpage",
+		}),
+		Namespaces: []string{"bookinfo"},
+	}.Check()
+
+	assert.True(valid)
+	assert.Len(vals, 1)
+	assert.Equal(models.WarningSeverity, vals[0].Severity)
+	assert.Equal("spec/rules[0]/from[0]/source/principals[1]", vals[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("authorizationpolicy.source.principalnotfound", vals[0]))
+}
+
+func TestInaccessibleNamespacePrincipal(t *testing.T) {
+	assert := assert.New(t)
+	conf := config.NewConfig()
+	conf.Deployment.AccessibleNamespaces = []string{"bookinfo"}
+	config.Set(conf)
+
+	vals, valid := PrincipalsChecker{
+		AuthorizationPolicy: principalsPolicy([]interface{}{"cluster.local/ns/other/sa/bookinfo-productpage"}),
+		Namespaces:          []string{"bookinfo"},
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestUnknownServiceAccountPrincipal(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := PrincipalsChecker{
+		AuthorizationPolicy: principalsPolicy([]interface{}{"cluster.local/ns/bookinfo/sa/wrong"}),
+		Namespaces:          []string{"bookinfo"},
+		ServiceAccounts:     map[string][]string{"bookinfo": {"bookinfo-productpage"}},
+	}.Check()
+
+	assert.True(valid)
+	assert.Len(vals, 1)
+	assert.Equal("spec/rules[0]/from[0]/source/principals[0]", vals[0].Path)
+}
+
+func TestWildcardPrincipal(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := PrincipalsChecker{
+		AuthorizationPolicy: principalsPolicy([]interface{}{"*", "*/sa/bookinfo-productpage", "cluster.local/ns/*/sa/foo", "cluster.local/ns/bookinfo/sa/*"}),
+		Namespaces:          []string{"bookinfo"},
+		ServiceAccounts:     map[string][]string{"bookinfo": {"bookinfo-productpage"}},
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func principalsPolicy(principals []interface{}) kubernetes.IstioObject {
+	ap := data.CreateAuthorizationPolicy([]interface{}{"bookinfo"}, []interface{}{"GET"}, []interface{}{"reviews"}, map[string]interface{}{"app": "reviews"})
+	rule := ap.GetSpec()["rules"].([]interface{})[0].(map[string]interface{})
+	rule["from"] = []interface{}{
+		map[string]interface{}{
+			"source": map[string]interface{}{
+				"principals": principals,
+			},
+		},
+	}
+	return ap
+}
@@ -0,0 +1,36 @@
+package authorization
+
+import (
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/kiali/kiali/business/checkers/common"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+type SelectorChecker struct {
+	AuthorizationPolicy kubernetes.IstioObject
+	WorkloadList        models.WorkloadList
+}
+
+// Check returns a warning when the policy selector matches no workload of its namespace, as the policy
+// then applies to nothing. Namespace-wide policies, without selector, are skipped.
+func (s SelectorChecker) Check() ([]*models.IstioCheck, bool) {
+	checks := make([]*models.IstioCheck, 0)
+
+	selectorLabels := common.GetSelectorLabels(s.AuthorizationPolicy)
+	if len(selectorLabels) == 0 {
+		return checks, true
+	}
+
+	selector := labels.SelectorFromSet(selectorLabels)
+	for _, wl := range s.WorkloadList.Workloads {
+		if selector.Matches(labels.Set(wl.Labels)) {
+			return checks, true
+		}
+	}
+
+	check := models.Build("authorizationpolicy.selector.nomatch", "spec/selector")
+	checks = append(checks, &check)
+	return checks, true
+}
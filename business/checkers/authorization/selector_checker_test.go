@@ -0,0 +1,58 @@
+package authorization
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestSelectorMatchingWorkload(t *testing.T) {
+	assert := assert.New(t)
+
+	vals, valid := SelectorChecker{
+		AuthorizationPolicy: data.CreateAuthorizationPolicy([]interface{}{"bookinfo"}, []interface{}{"GET"}, []interface{}{"details"},
+			map[string]interface{}{"app": "details"}),
+		WorkloadList: selectorWorkloads(),
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestSelectorNoMatchingWorkload(t *testing.T) {
+	assert := assert.New(t)
+
+	vals, valid := SelectorChecker{
+		AuthorizationPolicy: data.CreateAuthorizationPolicy([]interface{}{"bookinfo"}, []interface{}{"GET"}, []interface{}{"details"},
+			map[string]interface{}{"app": "detail"}),
+		WorkloadList: selectorWorkloads(),
+	}.Check()
+
+	assert.True(valid)
+	assert.Len(vals, 1)
+	assert.Equal(models.WarningSeverity, vals[0].Severity)
+	assert.Equal("spec/selector", vals[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("authorizationpolicy.selector.nomatch", vals[0]))
+}
+
+func TestNamespaceWidePolicy(t *testing.T) {
+	assert := assert.New(t)
+
+	ap := data.CreateAuthorizationPolicy([]interface{}{"bookinfo"}, []interface{}{"GET"}, []interface{}{"details"}, nil)
+	delete(ap.GetSpec(), "selector")
+
+	vals, valid := SelectorChecker{AuthorizationPolicy: ap, WorkloadList: selectorWorkloads()}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func selectorWorkloads() models.WorkloadList {
+	return data.CreateWorkloadList("bookinfo",
+		data.CreateWorkloadListItem("details-v1", map[string]string{"app": "details", "version": "v1"}),
+	)
+}
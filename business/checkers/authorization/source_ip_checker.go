@@ -0,0 +1,66 @@
+package authorization
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+type SourceIPChecker struct {
+	AuthorizationPolicy kubernetes.IstioObject
+}
+
+// Check returns an error for each source.ip condition value that is neither an IP nor a CIDR,
+// as such values never match any request.
+func (s SourceIPChecker) Check() ([]*models.IstioCheck, bool) {
+	checks := make([]*models.IstioCheck, 0)
+
+	rules, ok := s.AuthorizationPolicy.GetSpec()["rules"].([]interface{})
+	if !ok {
+		return checks, true
+	}
+
+	for ruleIdx, rule := range rules {
+		ruleMap, ok := rule.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		conditions, ok := ruleMap["when"].([]interface{})
+		if !ok {
+			continue
+		}
+
+		for whenIdx, condition := range conditions {
+			conditionMap, ok := condition.(map[string]interface{})
+			if !ok || conditionMap["key"] != "source.ip" {
+				continue
+			}
+
+			for _, field := range []string{"values", "notValues"} {
+				values, ok := conditionMap[field].([]interface{})
+				if !ok {
+					continue
+				}
+				for valueIdx, value := range values {
+					if ip, ok := value.(string); !ok || !isIPOrCIDR(ip) {
+						path := fmt.Sprintf("spec/rules[%d]/when[%d]/%s[%d]", ruleIdx, whenIdx, field, valueIdx)
+						validation := models.Build("authorizationpolicy.when.invalidsourceip", path)
+						checks = append(checks, &validation)
+					}
+				}
+			}
+		}
+	}
+
+	return checks, len(checks) == 0
+}
+
+func isIPOrCIDR(value string) bool {
+	if net.ParseIP(value) != nil {
+		return true
+	}
+	_, _, err := net.ParseCIDR(value)
+	return err == nil
+}
@@ -0,0 +1,49 @@
+package authorization
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestSourceIPValidValues(t *testing.T) {
+	assert := assert.New(t)
+
+	vals, valid := SourceIPChecker{
+		AuthorizationPolicy: sourceIPPolicy([]interface{}{"10.1.0.0/16", "192.168.1.7"}),
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestSourceIPInvalidValues(t *testing.T) {
+	assert := assert.New(t)
+
+	vals, valid := SourceIPChecker{
+		AuthorizationPolicy: sourceIPPolicy([]interface{}{"10.1.0.0/16", "reviews.bookinfo.svc.cluster.local"}),
+	}.Check()
+
+	assert.False(valid)
+	assert.Len(vals, 1)
+	assert.Equal(models.ErrorSeverity, vals[0].Severity)
+	assert.Equal("spec/rules[0]/when[0]/values[1]", vals[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("authorizationpolicy.when.invalidsourceip", vals[0]))
+}
+
+func sourceIPPolicy(values []interface{}) kubernetes.IstioObject {
+	ap := data.CreateAuthorizationPolicy([]interface{}{"bookinfo"}, []interface{}{"GET"}, []interface{}{"reviews"}, map[string]interface{}{"app": "reviews"})
+	rule := ap.GetSpec()["rules"].([]interface{})[0].(map[string]interface{})
+	rule["when"] = []interface{}{
+		map[string]interface{}{
+			"key":    "source.ip",
+			"values": values,
+		},
+	}
+	return ap
+}
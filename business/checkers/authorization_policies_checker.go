@@ -21,6 +21,9 @@ type AuthorizationPolicyChecker struct {
 	MtlsDetails           kubernetes.MTLSDetails
 	VirtualServices       []kubernetes.IstioObject
 	RegistryStatus        []*kubernetes.RegistryStatus
+	ExtensionProviders    []string
+	// ServiceAccounts holds the service accounts used by the workloads of each namespace
+	ServiceAccounts map[string][]string
 }
 
 func (a AuthorizationPolicyChecker) Check() models.IstioValidations {
@@ -48,10 +51,16 @@ func (a AuthorizationPolicyChecker) runChecks(authPolicy kubernetes.IstioObject)
 	serviceHosts := kubernetes.ServiceEntryHostnames(a.ServiceEntries)
 
 	enabledCheckers := []Checker{
-		common.SelectorNoWorkloadFoundChecker(AuthorizationPolicyCheckerType, authPolicy, a.WorkloadList),
+		authorization.SelectorChecker{AuthorizationPolicy: authPolicy, WorkloadList: a.WorkloadList},
 		authorization.NamespaceMethodChecker{AuthorizationPolicy: authPolicy, Namespaces: a.Namespaces.GetNames()},
 		authorization.NoHostChecker{AuthorizationPolicy: authPolicy, Namespace: a.Namespace, Namespaces: a.Namespaces,
 			ServiceEntries: serviceHosts, Services: a.Services, VirtualServices: a.VirtualServices, RegistryStatus: a.RegistryStatus},
+		authorization.IngressGatewayChecker{AuthorizationPolicy: authPolicy},
+		authorization.AllowNoRulesChecker{AuthorizationPolicy: authPolicy},
+		authorization.ExtensionProviderChecker{AuthorizationPolicy: authPolicy, ExtensionProviders: a.ExtensionProviders},
+		authorization.SourceIPChecker{AuthorizationPolicy: authPolicy},
+		authorization.PrincipalsChecker{AuthorizationPolicy: authPolicy, Namespaces: a.Namespaces.GetNames(), ServiceAccounts: a.ServiceAccounts},
+		common.NamingConventionChecker{IstioObject: authPolicy, ObjectType: kubernetes.AuthorizationPolicies},
 	}
 
 	for _, checker := range enabledCheckers {
@@ -0,0 +1,39 @@
+package common
+
+import (
+	"regexp"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/log"
+	"github.com/kiali/kiali/models"
+)
+
+type NamingConventionChecker struct {
+	IstioObject kubernetes.IstioObject
+	ObjectType  string
+}
+
+// Check returns a warning when the object name doesn't fully match the naming convention
+// configured for its object type. Object types without a convention are not checked.
+func (n NamingConventionChecker) Check() ([]*models.IstioCheck, bool) {
+	validations := make([]*models.IstioCheck, 0)
+
+	pattern, found := config.Get().KialiFeatureFlags.Validations.NamingConventions[n.ObjectType]
+	if !found || pattern == "" {
+		return validations, true
+	}
+
+	convention, err := regexp.Compile("^(?:" + pattern + ")$")
+	if err != nil {
+		log.Warningf("Invalid naming convention [%s] for %s: %v", pattern, n.ObjectType, err)
+		return validations, true
+	}
+
+	if !convention.MatchString(n.IstioObject.GetObjectMeta().Name) {
+		validation := models.Build("generic.naming.convention", "metadata/name")
+		validations = append(validations, &validation)
+	}
+
+	return validations, true
+}
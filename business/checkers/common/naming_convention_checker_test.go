@@ -0,0 +1,62 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestNamingConventionConforming(t *testing.T) {
+	assert := assert.New(t)
+	setNamingConvention(kubernetes.VirtualServices, "[a-z]+-vs")
+
+	vals, valid := NamingConventionChecker{
+		IstioObject: data.CreateEmptyVirtualService("reviews-vs", "bookinfo", []string{"reviews"}),
+		ObjectType:  kubernetes.VirtualServices,
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestNamingConventionNonConforming(t *testing.T) {
+	assert := assert.New(t)
+	setNamingConvention(kubernetes.VirtualServices, "[a-z]+-vs")
+
+	// The convention must match the whole name
+	vals, valid := NamingConventionChecker{
+		IstioObject: data.CreateEmptyVirtualService("reviews-vs-v2", "bookinfo", []string{"reviews"}),
+		ObjectType:  kubernetes.VirtualServices,
+	}.Check()
+
+	assert.True(valid)
+	assert.Len(vals, 1)
+	assert.Equal(models.WarningSeverity, vals[0].Severity)
+	assert.Equal("metadata/name", vals[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("generic.naming.convention", vals[0]))
+}
+
+func TestNamingConventionNotConfigured(t *testing.T) {
+	assert := assert.New(t)
+	setNamingConvention(kubernetes.VirtualServices, "[a-z]+-vs")
+
+	vals, valid := NamingConventionChecker{
+		IstioObject: data.CreateEmptyDestinationRule("bookinfo", "reviews", "reviews"),
+		ObjectType:  kubernetes.DestinationRules,
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func setNamingConvention(objectType, pattern string) {
+	conf := config.NewConfig()
+	conf.KialiFeatureFlags.Validations.NamingConventions = map[string]string{objectType: pattern}
+	config.Set(conf)
+}
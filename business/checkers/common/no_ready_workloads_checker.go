@@ -0,0 +1,74 @@
+package common
+
+import (
+	core_v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+type NoReadyWorkloadsChecker struct {
+	IstioObject  kubernetes.IstioObject
+	Namespaces   []string
+	Services     []core_v1.Service
+	WorkloadList models.WorkloadList
+}
+
+// Check returns an informational check when the object targets, through spec.host or spec.hosts,
+// a Service whose workloads have no available pods, as the configuration applies to an unavailable service.
+func (n NoReadyWorkloadsChecker) Check() ([]*models.IstioCheck, bool) {
+	validations := make([]*models.IstioCheck, 0)
+
+	path, hosts := "spec/host", make([]string, 0)
+	if host, ok := n.IstioObject.GetSpec()["host"].(string); ok {
+		hosts = append(hosts, host)
+	} else if specHosts, ok := n.IstioObject.GetSpec()["hosts"].([]interface{}); ok {
+		path = "spec/hosts"
+		for _, h := range specHosts {
+			if host, ok := h.(string); ok {
+				hosts = append(hosts, host)
+			}
+		}
+	}
+
+	for _, host := range hosts {
+		if n.hasNoReadyWorkloads(host) {
+			validation := models.Build("generic.workloads.noneready", path)
+			validations = append(validations, &validation)
+			break
+		}
+	}
+
+	return validations, true
+}
+
+// hasNoReadyWorkloads returns true when the host is a Service of the workloads namespace
+// selecting some workloads, none of them with available pods
+func (n NoReadyWorkloadsChecker) hasNoReadyWorkloads(host string) bool {
+	meta := n.IstioObject.GetObjectMeta()
+	fqdn := kubernetes.GetHost(host, meta.Namespace, meta.ClusterName, n.Namespaces)
+	if !fqdn.CompleteInput || fqdn.Namespace != n.WorkloadList.Namespace.Name {
+		return false
+	}
+
+	for _, svc := range n.Services {
+		if svc.Name != fqdn.Service || svc.Namespace != fqdn.Namespace || len(svc.Spec.Selector) == 0 {
+			continue
+		}
+
+		selector := labels.SelectorFromSet(labels.Set(svc.Spec.Selector))
+		selected := 0
+		for _, wl := range n.WorkloadList.Workloads {
+			if !selector.Matches(labels.Set(wl.Labels)) {
+				continue
+			}
+			if wl.AvailablePodCount > 0 {
+				return false
+			}
+			selected++
+		}
+		return selected > 0
+	}
+	return false
+}
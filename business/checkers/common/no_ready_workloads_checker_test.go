@@ -0,0 +1,86 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	core_v1 "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestReadyTargetWorkloads(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := NoReadyWorkloadsChecker{
+		IstioObject:  data.CreateEmptyDestinationRule("bookinfo", "reviews", "reviews"),
+		Namespaces:   []string{"bookinfo"},
+		Services:     []core_v1.Service{readinessService()},
+		WorkloadList: readinessWorkloads(0, 1),
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestUnreadyTargetWorkloads(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := NoReadyWorkloadsChecker{
+		IstioObject:  data.CreateEmptyDestinationRule("bookinfo", "reviews", "reviews"),
+		Namespaces:   []string{"bookinfo"},
+		Services:     []core_v1.Service{readinessService()},
+		WorkloadList: readinessWorkloads(0, 0),
+	}.Check()
+
+	assert.True(valid)
+	assert.Len(vals, 1)
+	assert.Equal(models.InfoSeverity, vals[0].Severity)
+	assert.Equal("spec/host", vals[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("generic.workloads.noneready", vals[0]))
+}
+
+func TestUnreadyTargetWorkloadsVirtualService(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := NoReadyWorkloadsChecker{
+		IstioObject:  data.CreateEmptyVirtualService("reviews", "bookinfo", []string{"reviews"}),
+		Namespaces:   []string{"bookinfo"},
+		Services:     []core_v1.Service{readinessService()},
+		WorkloadList: readinessWorkloads(0),
+	}.Check()
+
+	assert.True(valid)
+	assert.Len(vals, 1)
+	assert.Equal("spec/hosts", vals[0].Path)
+}
+
+func readinessService() core_v1.Service {
+	return core_v1.Service{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name:      "reviews",
+			Namespace: "bookinfo",
+		},
+		Spec: core_v1.ServiceSpec{
+			Selector: map[string]string{"app": "reviews"},
+		},
+	}
+}
+
+func readinessWorkloads(availablePodCounts ...int) models.WorkloadList {
+	workloads := data.CreateWorkloadList("bookinfo")
+	for _, availablePodCount := range availablePodCounts {
+		workload := data.CreateWorkloadListItem("reviews", map[string]string{"app": "reviews"})
+		workload.PodCount = 1
+		workload.AvailablePodCount = availablePodCount
+		workloads.Workloads = append(workloads.Workloads, workload)
+	}
+	return workloads
+}
@@ -0,0 +1,130 @@
+package checkers
+
+import (
+	"fmt"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+type CrossNamespaceSubsetResolver struct {
+	Namespaces       models.Namespaces
+	VirtualServices  []kubernetes.IstioObject
+	DestinationRules []kubernetes.IstioObject
+}
+
+// Resolve validates the VirtualService -> host -> DestinationRule subset chain of every VirtualService destination
+// pointing to a host of another namespace. The chain is broken either by the VirtualService, when no DestinationRule
+// defines the subset, or by the DestinationRules defining it, when none of them is exported to the VirtualService namespace.
+func (in CrossNamespaceSubsetResolver) Resolve() models.IstioValidations {
+	validations := models.IstioValidations{}
+	namespaces := in.Namespaces.GetNames()
+
+	for _, virtualService := range in.VirtualServices {
+		vsMeta := virtualService.GetObjectMeta()
+		for _, protocol := range []string{"http", "tcp", "tls"} {
+			routes, ok := virtualService.GetSpec()[protocol].([]interface{})
+			if !ok {
+				continue
+			}
+			for routeIdx, route := range routes {
+				routeDef, ok := route.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				destinationWeights, ok := routeDef["route"].([]interface{})
+				if !ok {
+					continue
+				}
+				for destWeightIdx, destinationWeight := range destinationWeights {
+					host, subset, ok := destinationHostSubset(destinationWeight)
+					if !ok {
+						continue
+					}
+
+					vsHost := kubernetes.GetHost(host, vsMeta.Namespace, vsMeta.ClusterName, namespaces)
+					if !vsHost.CompleteInput || vsHost.Namespace == vsMeta.Namespace {
+						// Same namespace chains are covered by the SubsetPresenceChecker
+						continue
+					}
+
+					defining := in.definingDestinationRules(vsHost, subset)
+					if len(defining) == 0 {
+						path := fmt.Sprintf("spec/%s[%d]/route[%d]/destination", protocol, routeIdx, destWeightIdx)
+						addCrossNamespaceCheck(validations, virtualService, VirtualCheckerType, models.Build("virtualservices.subsetpresent.crossnamespacenotfound", path))
+						continue
+					}
+
+					exported := false
+					for _, dr := range defining {
+						if models.IsExportedTo(kubernetes.ExportTo(dr), dr.GetObjectMeta().Namespace, vsMeta.Namespace) {
+							exported = true
+							break
+						}
+					}
+					if !exported {
+						for _, dr := range defining {
+							addCrossNamespaceCheck(validations, dr, DestinationRuleCheckerType, models.Build("destinationrules.exportto.subsetnotvisible", "spec/exportTo"))
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return validations
+}
+
+// definingDestinationRules returns the DestinationRules of the host defining the subset
+func (in CrossNamespaceSubsetResolver) definingDestinationRules(host kubernetes.Host, subset string) []kubernetes.IstioObject {
+	drs := make([]kubernetes.IstioObject, 0)
+	for _, dr := range in.DestinationRules {
+		drHostName, ok := dr.GetSpec()["host"].(string)
+		if !ok {
+			continue
+		}
+		drMeta := dr.GetObjectMeta()
+		drHost := kubernetes.GetHost(drHostName, drMeta.Namespace, drMeta.ClusterName, in.Namespaces.GetNames())
+		if !kubernetes.FilterByHost(host.String(), drHost.Service, drHost.Namespace) {
+			continue
+		}
+		if subsets, ok := dr.GetSpec()["subsets"].([]interface{}); ok {
+			for _, s := range subsets {
+				if subsetDef, ok := s.(map[string]interface{}); ok && subsetDef["name"] == subset {
+					drs = append(drs, dr)
+					break
+				}
+			}
+		}
+	}
+	return drs
+}
+
+func destinationHostSubset(destinationWeight interface{}) (string, string, bool) {
+	destinationWeightDef, ok := destinationWeight.(map[string]interface{})
+	if !ok {
+		return "", "", false
+	}
+	destination, ok := destinationWeightDef["destination"].(map[string]interface{})
+	if !ok {
+		return "", "", false
+	}
+	host, hostOk := destination["host"].(string)
+	subset, subsetOk := destination["subset"].(string)
+	return host, subset, hostOk && subsetOk
+}
+
+func addCrossNamespaceCheck(validations models.IstioValidations, istioObject kubernetes.IstioObject, objectType string, check models.IstioCheck) {
+	key, validation := EmptyValidValidation(istioObject.GetObjectMeta().Name, istioObject.GetObjectMeta().Namespace, objectType)
+	if existing, found := validations[key]; found {
+		validation = existing
+	}
+	for _, c := range validation.Checks {
+		if c.Code == check.Code && c.Path == check.Path {
+			return
+		}
+	}
+	validation.Checks = append(validation.Checks, &check)
+	validation.Valid = false
+	validations[key] = validation
+}
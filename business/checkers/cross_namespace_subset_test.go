@@ -0,0 +1,72 @@
+package checkers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestCrossNamespaceSubsetAligned(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals := crossNamespaceResolver(data.CreateTestDestinationRule("bookinfo", "reviews", "reviews")).Resolve()
+
+	assert.Empty(vals)
+}
+
+func TestCrossNamespaceSubsetNotExported(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	dr := data.CreateTestDestinationRule("bookinfo", "reviews", "reviews")
+	dr.GetSpec()["exportTo"] = []interface{}{"."}
+
+	vals := crossNamespaceResolver(dr).Resolve()
+
+	assert.Len(vals, 1)
+	validation, ok := vals[models.IstioValidationKey{ObjectType: DestinationRuleCheckerType, Namespace: "bookinfo", Name: "reviews"}]
+	assert.True(ok)
+	assert.False(validation.Valid)
+	assert.Len(validation.Checks, 1)
+	assert.Equal(models.ErrorSeverity, validation.Checks[0].Severity)
+	assert.Equal("spec/exportTo", validation.Checks[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("destinationrules.exportto.subsetnotvisible", validation.Checks[0]))
+}
+
+func TestCrossNamespaceSubsetNotFound(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals := crossNamespaceResolver(data.CreateEmptyDestinationRule("bookinfo", "reviews", "reviews")).Resolve()
+
+	assert.Len(vals, 1)
+	validation, ok := vals[models.IstioValidationKey{ObjectType: VirtualCheckerType, Namespace: "test", Name: "reviews"}]
+	assert.True(ok)
+	assert.False(validation.Valid)
+	assert.Len(validation.Checks, 2)
+	assert.Equal("spec/http[0]/route[0]/destination", validation.Checks[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("virtualservices.subsetpresent.crossnamespacenotfound", validation.Checks[0]))
+	assert.Equal("spec/tcp[0]/route[0]/destination", validation.Checks[1].Path)
+}
+
+func crossNamespaceResolver(dr kubernetes.IstioObject) CrossNamespaceSubsetResolver {
+	vs := data.CreateVirtualService()
+	for _, protocol := range []string{"http", "tcp"} {
+		route := vs.GetSpec()[protocol].([]interface{})[0].(map[string]interface{})
+		destination := route["route"].([]interface{})[0].(map[string]interface{})["destination"].(map[string]interface{})
+		destination["host"] = "reviews.bookinfo.svc.cluster.local"
+	}
+
+	return CrossNamespaceSubsetResolver{
+		Namespaces:       models.Namespaces{models.Namespace{Name: "test"}, models.Namespace{Name: "bookinfo"}},
+		VirtualServices:  []kubernetes.IstioObject{vs},
+		DestinationRules: []kubernetes.IstioObject{dr},
+	}
+}
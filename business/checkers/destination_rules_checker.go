@@ -1,6 +1,8 @@
 package checkers
 
 import (
+	core_v1 "k8s.io/api/core/v1"
+
 	"github.com/kiali/kiali/business/checkers/common"
 	"github.com/kiali/kiali/business/checkers/destinationrules"
 	"github.com/kiali/kiali/kubernetes"
@@ -13,7 +15,9 @@ type DestinationRulesChecker struct {
 	DestinationRules []kubernetes.IstioObject
 	MTLSDetails      kubernetes.MTLSDetails
 	ServiceEntries   []kubernetes.IstioObject
-	Namespaces       []models.Namespace
+	Namespaces       models.Namespaces
+	Services         []core_v1.Service
+	WorkloadList     models.WorkloadList
 }
 
 func (in DestinationRulesChecker) Check() models.IstioValidations {
@@ -63,7 +67,21 @@ func (in DestinationRulesChecker) runChecks(destinationRule kubernetes.IstioObje
 	enabledCheckers := []Checker{
 		destinationrules.DisabledNamespaceWideMTLSChecker{DestinationRule: destinationRule, MTLSDetails: in.MTLSDetails},
 		destinationrules.DisabledMeshWideMTLSChecker{DestinationRule: destinationRule, MeshPeerAuthns: in.MTLSDetails.MeshPeerAuthentications},
+		destinationrules.PeerAuthenticationMTLSChecker{DestinationRule: destinationRule, Namespaces: in.Namespaces.GetNames(), PeerAuthentications: in.MTLSDetails.PeerAuthentications},
 		common.ExportToNamespaceChecker{IstioObject: destinationRule, Namespaces: in.Namespaces},
+		destinationrules.ExportToServiceChecker{DestinationRule: destinationRule, Namespaces: in.Namespaces.GetNames(), Services: in.Services, ServiceEntries: in.ServiceEntries},
+		common.NamingConventionChecker{IstioObject: destinationRule, ObjectType: kubernetes.DestinationRules},
+		common.NoReadyWorkloadsChecker{IstioObject: destinationRule, Namespaces: in.Namespaces.GetNames(), Services: in.Services, WorkloadList: in.WorkloadList},
+		destinationrules.OutlierDetectionChecker{DestinationRule: destinationRule},
+		destinationrules.OutlierDetectionBoundsChecker{DestinationRule: destinationRule},
+		destinationrules.ConsistentHashKeyChecker{DestinationRule: destinationRule},
+		destinationrules.MultiMatchSubsetChecker{DestinationRule: destinationRule},
+		destinationrules.ServiceEntryResolutionChecker{DestinationRule: destinationRule, ServiceEntries: in.ServiceEntries},
+		destinationrules.ServiceEntryTLSModeChecker{DestinationRule: destinationRule, ServiceEntries: in.ServiceEntries},
+		destinationrules.ExternalNameServiceChecker{DestinationRule: destinationRule, Namespaces: in.Namespaces.GetNames(), Services: in.Services, ServiceEntries: in.ServiceEntries},
+		destinationrules.VersionLabelChecker{DestinationRule: destinationRule, Namespaces: in.Namespaces.GetNames(), WorkloadList: in.WorkloadList},
+		destinationrules.WarmupSingleEndpointChecker{DestinationRule: destinationRule, Namespaces: in.Namespaces.GetNames(), Services: in.Services, WorkloadList: in.WorkloadList},
+		destinationrules.SubsetLabelCoverageChecker{DestinationRule: destinationRule, Namespaces: in.Namespaces.GetNames(), Services: in.Services, WorkloadList: in.WorkloadList},
 	}
 
 	// Appending validations that only applies to non-autoMTLS meshes
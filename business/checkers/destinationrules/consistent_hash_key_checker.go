@@ -0,0 +1,67 @@
+package destinationrules
+
+import (
+	"fmt"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+type ConsistentHashKeyChecker struct {
+	DestinationRule kubernetes.IstioObject
+}
+
+// hashKeys are the consistentHash fields selecting the key requests are hashed on
+var hashKeys = []string{"httpHeaderName", "httpCookie", "useSourceIp", "httpQueryParameterName"}
+
+// Check returns a warning for each host-level or subset loadBalancer setting a consistentHash without any hash key,
+// as Istio silently falls back and requests don't stick to any endpoint.
+func (c ConsistentHashKeyChecker) Check() ([]*models.IstioCheck, bool) {
+	validations := make([]*models.IstioCheck, 0)
+
+	dr := models.DestinationRule{}
+	dr.Parse(c.DestinationRule)
+
+	if loadBalancer, found := dr.LoadBalancer(""); found && !hasHashKey(loadBalancer) {
+		validation := models.Build("destinationrules.consistenthash.nokey", "spec/trafficPolicy/loadBalancer/consistentHash")
+		validations = append(validations, &validation)
+	}
+
+	if subsets, ok := dr.Spec.Subsets.([]interface{}); ok {
+		for i, subset := range subsets {
+			if subsetCasted, ok := subset.(map[string]interface{}); ok {
+				name, _ := subsetCasted["name"].(string)
+				if loadBalancer, found := dr.SubsetLoadBalancer(name); found && !hasHashKey(loadBalancer) {
+					validation := models.Build("destinationrules.consistenthash.nokey", fmt.Sprintf("spec/subsets[%d]/trafficPolicy/loadBalancer/consistentHash", i))
+					validations = append(validations, &validation)
+				}
+			}
+		}
+	}
+
+	return validations, true
+}
+
+// hasHashKey returns false only when the loadBalancer sets a consistentHash without any hash key
+func hasHashKey(loadBalancer map[string]interface{}) bool {
+	consistentHash, found := loadBalancer["consistentHash"]
+	if !found {
+		return true
+	}
+
+	consistentHashCasted, ok := consistentHash.(map[string]interface{})
+	if !ok {
+		return false
+	}
+	for _, key := range hashKeys {
+		value, found := consistentHashCasted[key]
+		if !found {
+			continue
+		}
+		if useSourceIp, ok := value.(bool); ok && !useSourceIp {
+			continue
+		}
+		return true
+	}
+	return false
+}
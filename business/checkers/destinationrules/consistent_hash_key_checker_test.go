@@ -0,0 +1,78 @@
+package destinationrules
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestConsistentHashCookie(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := ConsistentHashKeyChecker{DestinationRule: consistentHashDestinationRule(map[string]interface{}{
+		"httpCookie": map[string]interface{}{"name": "user", "ttl": "0s"},
+	})}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestConsistentHashSourceIp(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := ConsistentHashKeyChecker{DestinationRule: consistentHashDestinationRule(map[string]interface{}{
+		"useSourceIp": true,
+	})}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestConsistentHashNoKey(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := ConsistentHashKeyChecker{DestinationRule: consistentHashDestinationRule(map[string]interface{}{})}.Check()
+
+	assert.True(valid)
+	assert.Len(vals, 1)
+	assert.Equal(models.WarningSeverity, vals[0].Severity)
+	assert.Equal("spec/trafficPolicy/loadBalancer/consistentHash", vals[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("destinationrules.consistenthash.nokey", vals[0]))
+}
+
+func TestConsistentHashSubsetNoKey(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	subset := data.CreateSubset("v1", "v1")
+	subset["trafficPolicy"] = map[string]interface{}{
+		"loadBalancer": map[string]interface{}{
+			"consistentHash": map[string]interface{}{"useSourceIp": false},
+		},
+	}
+	dr := data.AddSubsetToDestinationRule(subset, data.CreateEmptyDestinationRule("bookinfo", "reviews", "reviews"))
+
+	vals, valid := ConsistentHashKeyChecker{DestinationRule: dr}.Check()
+
+	assert.True(valid)
+	assert.Len(vals, 1)
+	assert.Equal("spec/subsets[0]/trafficPolicy/loadBalancer/consistentHash", vals[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("destinationrules.consistenthash.nokey", vals[0]))
+}
+
+func consistentHashDestinationRule(consistentHash map[string]interface{}) kubernetes.IstioObject {
+	return data.AddTrafficPolicyToDestinationRule(map[string]interface{}{
+		"loadBalancer": map[string]interface{}{
+			"consistentHash": consistentHash,
+		},
+	}, data.CreateEmptyDestinationRule("bookinfo", "reviews", "reviews"))
+}
@@ -0,0 +1,80 @@
+package destinationrules
+
+import (
+	"strings"
+
+	core_v1 "k8s.io/api/core/v1"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+const serviceExportToAnnotation = "networking.istio.io/exportTo"
+
+type ExportToServiceChecker struct {
+	DestinationRule kubernetes.IstioObject
+	Namespaces      []string
+	Services        []core_v1.Service
+	ServiceEntries  []kubernetes.IstioObject
+}
+
+// Check returns a warning when the DestinationRule is exported to namespaces that can't see the Service or
+// ServiceEntry it targets, as those namespaces import the DestinationRule without the host it applies to.
+func (e ExportToServiceChecker) Check() ([]*models.IstioCheck, bool) {
+	validations := make([]*models.IstioCheck, 0)
+
+	host, ok := e.DestinationRule.GetSpec()["host"].(string)
+	if !ok {
+		return validations, true
+	}
+
+	meta := e.DestinationRule.GetObjectMeta()
+	drExportTo := kubernetes.ExportTo(e.DestinationRule)
+
+	svcExportTo, svcNamespace, found := e.findTarget(host)
+	if !found {
+		return validations, true
+	}
+
+	if len(models.ExportedBeyond(drExportTo, meta.Namespace, svcExportTo, svcNamespace, e.Namespaces)) > 0 {
+		validation := models.Build("destinationrules.exportto.broaderthanservice", "spec/exportTo")
+		validations = append(validations, &validation)
+	}
+
+	return validations, true
+}
+
+// findTarget returns the exportTo values and the namespace of the Service or ServiceEntry the host refers to
+func (e ExportToServiceChecker) findTarget(host string) ([]string, string, bool) {
+	meta := e.DestinationRule.GetObjectMeta()
+	drHost := kubernetes.GetHost(host, meta.Namespace, meta.ClusterName, e.Namespaces)
+	if drHost.CompleteInput {
+		for _, svc := range e.Services {
+			if svc.Name == drHost.Service && svc.Namespace == drHost.Namespace {
+				return serviceExportTo(svc), svc.Namespace, true
+			}
+		}
+	}
+
+	for _, se := range e.ServiceEntries {
+		if serviceEntryCoversHost(se, host) {
+			return kubernetes.ExportTo(se), se.GetObjectMeta().Namespace, true
+		}
+	}
+
+	return nil, "", false
+}
+
+func serviceExportTo(svc core_v1.Service) []string {
+	annotation, found := svc.Annotations[serviceExportToAnnotation]
+	if !found {
+		return nil
+	}
+	values := make([]string, 0)
+	for _, value := range strings.Split(annotation, ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
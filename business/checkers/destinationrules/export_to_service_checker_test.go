@@ -0,0 +1,79 @@
+package destinationrules
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	core_v1 "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestExportToAlignedWithService(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := ExportToServiceChecker{
+		DestinationRule: exportedDestinationRule("."),
+		Namespaces:      []string{"bookinfo", "bookinfo2"},
+		Services:        []core_v1.Service{exportedService(".")},
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestExportToBroaderThanService(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := ExportToServiceChecker{
+		DestinationRule: exportedDestinationRule("*"),
+		Namespaces:      []string{"bookinfo", "bookinfo2"},
+		Services:        []core_v1.Service{exportedService(".")},
+	}.Check()
+
+	assert.True(valid)
+	assert.Len(vals, 1)
+	assert.Equal(models.WarningSeverity, vals[0].Severity)
+	assert.Equal("spec/exportTo", vals[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("destinationrules.exportto.broaderthanservice", vals[0]))
+}
+
+func TestExportToServiceWithoutAnnotation(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	svc := exportedService("")
+	svc.Annotations = nil
+
+	vals, valid := ExportToServiceChecker{
+		DestinationRule: data.CreateEmptyDestinationRule("bookinfo", "reviews", "reviews"),
+		Namespaces:      []string{"bookinfo", "bookinfo2"},
+		Services:        []core_v1.Service{svc},
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func exportedDestinationRule(exportTo ...interface{}) kubernetes.IstioObject {
+	dr := data.CreateEmptyDestinationRule("bookinfo", "reviews", "reviews")
+	dr.GetSpec()["exportTo"] = exportTo
+	return dr
+}
+
+func exportedService(exportTo string) core_v1.Service {
+	return core_v1.Service{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name:        "reviews",
+			Namespace:   "bookinfo",
+			Annotations: map[string]string{serviceExportToAnnotation: exportTo},
+		},
+	}
+}
@@ -0,0 +1,58 @@
+package destinationrules
+
+import (
+	core_v1 "k8s.io/api/core/v1"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+type ExternalNameServiceChecker struct {
+	DestinationRule kubernetes.IstioObject
+	Namespaces      []string
+	Services        []core_v1.Service
+	ServiceEntries  []kubernetes.IstioObject
+}
+
+// Check returns an informational check when the DestinationRule host is a Service of type ExternalName
+// and no ServiceEntry covers either that host or the external name it points to.
+// ExternalName services behave like CNAMEs, so Istio may not apply the traffic policy without a ServiceEntry.
+func (e ExternalNameServiceChecker) Check() ([]*models.IstioCheck, bool) {
+	validations := make([]*models.IstioCheck, 0)
+
+	host, ok := e.DestinationRule.GetSpec()["host"].(string)
+	if !ok {
+		return validations, true
+	}
+
+	meta := e.DestinationRule.GetObjectMeta()
+	drHost := kubernetes.GetHost(host, meta.Namespace, meta.ClusterName, e.Namespaces)
+	if !drHost.CompleteInput {
+		return validations, true
+	}
+
+	for _, svc := range e.Services {
+		if svc.Name != drHost.Service || svc.Namespace != drHost.Namespace || svc.Spec.Type != core_v1.ServiceTypeExternalName {
+			continue
+		}
+		if !e.hasServiceEntry(host, svc) {
+			validation := models.Build("destinationrules.externalname.serviceentrymissing", "spec/host")
+			validations = append(validations, &validation)
+		}
+		break
+	}
+
+	return validations, true
+}
+
+func (e ExternalNameServiceChecker) hasServiceEntry(host string, svc core_v1.Service) bool {
+	for _, se := range e.ServiceEntries {
+		if serviceEntryCoversHost(se, host) {
+			return true
+		}
+		if svc.Spec.ExternalName != "" && serviceEntryCoversHost(se, svc.Spec.ExternalName) {
+			return true
+		}
+	}
+	return false
+}
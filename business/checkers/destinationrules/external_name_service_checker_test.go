@@ -0,0 +1,79 @@
+package destinationrules
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	core_v1 "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestExternalNameServiceWithoutServiceEntry(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := ExternalNameServiceChecker{
+		DestinationRule: data.CreateEmptyDestinationRule("bookinfo", "wikipedia", "wikipedia"),
+		Namespaces:      []string{"bookinfo"},
+		Services:        []core_v1.Service{externalNameService("wikipedia", "bookinfo", "en.wikipedia.org")},
+	}.Check()
+
+	assert.True(valid)
+	assert.Len(vals, 1)
+	assert.Equal(models.InfoSeverity, vals[0].Severity)
+	assert.Equal("spec/host", vals[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("destinationrules.externalname.serviceentrymissing", vals[0]))
+}
+
+func TestExternalNameServiceWithServiceEntry(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := ExternalNameServiceChecker{
+		DestinationRule: data.CreateEmptyDestinationRule("bookinfo", "wikipedia", "wikipedia.bookinfo.svc.cluster.local"),
+		Namespaces:      []string{"bookinfo"},
+		Services:        []core_v1.Service{externalNameService("wikipedia", "bookinfo", "en.wikipedia.org")},
+		ServiceEntries: []kubernetes.IstioObject{
+			data.CreateEmptyMeshExternalServiceEntry("wikipedia", "bookinfo", []string{"*.wikipedia.org"}),
+		},
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestExternalNameCheckerRegularService(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	svc := externalNameService("reviews", "bookinfo", "")
+	svc.Spec.Type = core_v1.ServiceTypeClusterIP
+
+	vals, valid := ExternalNameServiceChecker{
+		DestinationRule: data.CreateEmptyDestinationRule("bookinfo", "reviews", "reviews"),
+		Namespaces:      []string{"bookinfo"},
+		Services:        []core_v1.Service{svc},
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func externalNameService(name, namespace, externalName string) core_v1.Service {
+	return core_v1.Service{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: core_v1.ServiceSpec{
+			Type:         core_v1.ServiceTypeExternalName,
+			ExternalName: externalName,
+		},
+	}
+}
@@ -0,0 +1,60 @@
+package destinationrules
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+type MultiMatchSubsetChecker struct {
+	DestinationRule kubernetes.IstioObject
+}
+
+// Check returns a warning for each subset whose labels are the same as, or a strict superset of,
+// the labels of another subset in the same DestinationRule, as routing to them becomes ambiguous.
+// Subsets without labels are not considered.
+func (m MultiMatchSubsetChecker) Check() ([]*models.IstioCheck, bool) {
+	validations := make([]*models.IstioCheck, 0)
+
+	subsets, ok := m.DestinationRule.GetSpec()["subsets"].([]interface{})
+	if !ok {
+		return validations, true
+	}
+
+	subsetLabels := make([]labels.Set, len(subsets))
+	for i, subset := range subsets {
+		if subsetCasted, ok := subset.(map[string]interface{}); ok {
+			if lbls, ok := subsetCasted["labels"].(map[string]interface{}); ok {
+				set := labels.Set{}
+				for k, v := range lbls {
+					if value, ok := v.(string); ok {
+						set[k] = value
+					}
+				}
+				subsetLabels[i] = set
+			}
+		}
+	}
+
+	for i, set := range subsetLabels {
+		if len(set) == 0 {
+			continue
+		}
+		for j, other := range subsetLabels {
+			if i == j || len(other) == 0 || len(set) < len(other) {
+				continue
+			}
+			// set contains every label of other, so it's a duplicate or a strict superset
+			if labels.SelectorFromSet(other).Matches(set) {
+				validation := models.Build("destinationrules.multimatch.subsets", fmt.Sprintf("spec/subsets[%d]", i))
+				validations = append(validations, &validation)
+				break
+			}
+		}
+	}
+
+	return validations, true
+}
@@ -0,0 +1,64 @@
+package destinationrules
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestMultiMatchSubsetsDistinct(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	dr := data.AddSubsetToDestinationRule(data.CreateSubset("v2", "v2"),
+		data.AddSubsetToDestinationRule(data.CreateSubset("v1", "v1"),
+			data.CreateEmptyDestinationRule("bookinfo", "reviews", "reviews")))
+
+	vals, valid := MultiMatchSubsetChecker{DestinationRule: dr}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestMultiMatchSubsetsDuplicate(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	dr := data.AddSubsetToDestinationRule(data.CreateSubset("v1-copy", "v1"),
+		data.AddSubsetToDestinationRule(data.CreateSubset("v2", "v2"),
+			data.AddSubsetToDestinationRule(data.CreateSubset("v1", "v1"),
+				data.CreateEmptyDestinationRule("bookinfo", "reviews", "reviews"))))
+
+	vals, valid := MultiMatchSubsetChecker{DestinationRule: dr}.Check()
+
+	assert.True(valid)
+	assert.Len(vals, 2)
+	assert.Equal(models.WarningSeverity, vals[0].Severity)
+	assert.NoError(validations.ConfirmIstioCheckMessage("destinationrules.multimatch.subsets", vals[0]))
+	assert.Equal("spec/subsets[0]", vals[0].Path)
+	assert.Equal("spec/subsets[2]", vals[1].Path)
+}
+
+func TestMultiMatchSubsetsSuperset(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	canary := data.CreateSubset("v1-canary", "v1")
+	canary["labels"].(map[string]interface{})["track"] = "canary"
+
+	dr := data.AddSubsetToDestinationRule(data.CreateNoLabelsSubset("all"),
+		data.AddSubsetToDestinationRule(canary,
+			data.AddSubsetToDestinationRule(data.CreateSubset("v1", "v1"),
+				data.CreateEmptyDestinationRule("bookinfo", "reviews", "reviews"))))
+
+	vals, valid := MultiMatchSubsetChecker{DestinationRule: dr}.Check()
+
+	assert.True(valid)
+	assert.Len(vals, 1)
+	assert.Equal("spec/subsets[1]", vals[0].Path)
+}
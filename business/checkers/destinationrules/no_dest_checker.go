@@ -1,6 +1,7 @@
 package destinationrules
 
 import (
+	"fmt"
 	"strconv"
 	"strings"
 
@@ -16,9 +17,11 @@ type NoDestinationChecker struct {
 	Namespaces      models.Namespaces
 	WorkloadList    models.WorkloadList
 	DestinationRule kubernetes.IstioObject
-	ServiceEntries  map[string][]string
+	ServiceEntries  []kubernetes.IstioObject
 	Services        []core_v1.Service
 	RegistryStatus  []*kubernetes.RegistryStatus
+	// ClusterRegistryStatus holds the registry of each remote cluster, keyed by cluster name
+	ClusterRegistryStatus map[string][]*kubernetes.RegistryStatus
 }
 
 // Check parses the DestinationRule definitions and verifies that they point to an existing service, including any subset definitions
@@ -31,7 +34,15 @@ func (n NoDestinationChecker) Check() ([]*models.IstioCheck, bool) {
 			fqdn := kubernetes.GetHost(dHost, n.DestinationRule.GetObjectMeta().Namespace, n.DestinationRule.GetObjectMeta().ClusterName, n.Namespaces.GetNames())
 			// Testing Kubernetes Services + Istio ServiceEntries + Istio Runtime Registry (cross namespace)
 			if !n.hasMatchingService(fqdn, n.DestinationRule.GetObjectMeta().Namespace) {
-				validation := models.Build("destinationrules.nodest.matchingregistry", "spec/host")
+				var validation models.IstioCheck
+				if fqdn.CompleteInput && !strings.HasPrefix(fqdn.Service, "*") && n.Namespaces.Includes(fqdn.Namespace) {
+					// The host points to a known namespace, only the service is missing
+					validation = models.Build("destinationrules.nodest.servicemissinginns", "spec/host")
+					validation.Remediation = fmt.Sprintf("Create the Service %s in namespace %s, or fix the host", fqdn.Service, fqdn.Namespace)
+				} else {
+					validation = models.Build("destinationrules.nodest.matchingregistry", "spec/host")
+					validation.Remediation = fmt.Sprintf("Create a Service or a ServiceEntry for host %s, or fix the host", dHost)
+				}
 				valid = false
 				validations = append(validations, &validation)
 			} else if subsets, ok := n.DestinationRule.GetSpec()["subsets"]; ok {
@@ -39,17 +50,13 @@ func (n NoDestinationChecker) Check() ([]*models.IstioCheck, bool) {
 					// Check that each subset has a matching workload somewhere..
 					for i, subset := range dSubsets {
 						if innerSubset, ok := subset.(map[string]interface{}); ok {
-							if labels, ok := innerSubset["labels"]; ok {
-								if dLabels, ok := labels.(map[string]interface{}); ok {
-									stringLabels := make(map[string]string, len(dLabels))
-									for k, v := range dLabels {
-										if s, ok := v.(string); ok {
-											stringLabels[k] = s
-										}
-									}
+							if _, ok := innerSubset["labels"]; ok {
+								if stringLabels, ok := SubsetLabels(innerSubset); ok {
 									if !n.hasMatchingWorkload(fqdn.Service, stringLabels) {
 										validation := models.Build("destinationrules.nodest.subsetlabels",
 											"spec/subsets["+strconv.Itoa(i)+"]")
+										validation.Remediation = fmt.Sprintf("Deploy a workload of service %s labeled %s, or fix the subset labels",
+											fqdn.Service, formatLabels(stringLabels))
 										validations = append(validations, &validation)
 										valid = false
 									}
@@ -114,36 +121,37 @@ func (n NoDestinationChecker) hasMatchingWorkload(service string, subsetLabels m
 	return false
 }
 
-func (n NoDestinationChecker) hasMatchingService(host kubernetes.Host, itemNamespace string) bool {
-	// Check wildcard hosts - needs to match "*" and "*.suffix" also..
-	if strings.HasPrefix(host.Service, "*") {
-		return true
+// SubsetLabels returns the string labels of a DestinationRule subset definition,
+// or false when the subset doesn't define a labels map
+func SubsetLabels(subset map[string]interface{}) (map[string]string, bool) {
+	dLabels, ok := subset["labels"].(map[string]interface{})
+	if !ok {
+		return nil, false
 	}
-
-	// Covering 'servicename.namespace' host format scenario
-	localSvc, localNs := kubernetes.ParseTwoPartHost(host)
-
-	if localNs == itemNamespace {
-		// Check Workloads
-		if matches := kubernetes.HasMatchingWorkloads(localSvc, n.WorkloadList.GetLabels()); matches {
-			return matches
-		}
-
-		// Check ServiceNames
-		if matches := kubernetes.HasMatchingServices(localSvc, n.Services); matches {
-			return matches
+	stringLabels := make(map[string]string, len(dLabels))
+	for k, v := range dLabels {
+		if s, ok := v.(string); ok {
+			stringLabels[k] = s
 		}
 	}
+	return stringLabels, true
+}
+
+// formatLabels returns the labels sorted by key in the key=value,key=value form
+func formatLabels(subsetLabels map[string]string) string {
+	return labels.Set(subsetLabels).String()
+}
 
-	// Check ServiceEntries
-	if kubernetes.HasMatchingServiceEntries(host.Service, n.ServiceEntries) {
+func (n NoDestinationChecker) hasMatchingService(host kubernetes.Host, itemNamespace string) bool {
+	if models.ClassifyHost(host, itemNamespace, n.WorkloadList, n.Services, n.ServiceEntries, n.RegistryStatus) != models.HostUnknown {
 		return true
 	}
 
-	// Use RegistryStatus to check destinations that may not be covered with previous check
-	// i.e. Multi-cluster or Federation validations
-	if kubernetes.HasMatchingRegistryStatus(host.String(), n.RegistryStatus) {
-		return true
+	// In multicluster setups the host may only be known by the registry of a remote cluster
+	for _, registryStatus := range n.ClusterRegistryStatus {
+		if models.ClassifyHost(host, itemNamespace, n.WorkloadList, n.Services, n.ServiceEntries, registryStatus) != models.HostUnknown {
+			return true
+		}
 	}
 	return false
 }
@@ -173,6 +173,34 @@ func TestNoValidHost(t *testing.T) {
 	assert.NotEmpty(vals)
 	assert.Equal(models.ErrorSeverity, vals[0].Severity)
 	assert.NoError(validations.ConfirmIstioCheckMessage("destinationrules.nodest.matchingregistry", vals[0]))
+	assert.Equal("Create a Service or a ServiceEntry for host reviews, or fix the host", vals[0].Remediation)
+	assert.Equal("spec/host", vals[0].Path)
+}
+
+func TestServiceMissingInExistingNamespace(t *testing.T) {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	assert := assert.New(t)
+
+	// test-namespace is known but reviews is not part of its services
+	vals, valid := NoDestinationChecker{
+		Namespace: "test-namespace",
+		Namespaces: models.Namespaces{
+			models.Namespace{Name: "test-namespace"},
+		},
+		WorkloadList: data.CreateWorkloadList("test-namespace",
+			data.CreateWorkloadListItem("detailsv1", appVersionLabel("details", "v1")),
+		),
+		Services:        []core_v1.Service{},
+		DestinationRule: data.CreateTestDestinationRule("test-namespace", "name", "reviews"),
+	}.Check()
+
+	assert.False(valid)
+	assert.Len(vals, 1)
+	assert.Equal(models.ErrorSeverity, vals[0].Severity)
+	assert.NoError(validations.ConfirmIstioCheckMessage("destinationrules.nodest.servicemissinginns", vals[0]))
+	assert.Equal("Create the Service reviews in namespace test-namespace, or fix the host", vals[0].Remediation)
 	assert.Equal("spec/host", vals[0].Path)
 }
 
@@ -197,6 +225,7 @@ func TestNoMatchingSubset(t *testing.T) {
 	assert.Equal(models.ErrorSeverity, vals[0].Severity)
 	assert.NoError(validations.ConfirmIstioCheckMessage("destinationrules.nodest.subsetlabels", vals[0]))
 	assert.Equal("spec/subsets[0]", vals[0].Path)
+	assert.Equal("Deploy a workload of service reviews labeled version=v2, or fix the subset labels", vals[0].Remediation)
 }
 
 func TestNoMatchingSubsetWithMoreLabels(t *testing.T) {
@@ -252,6 +281,27 @@ func fakeServicesReview() []core_v1.Service {
 	}
 }
 
+func TestEmptyLabelsInSubset(t *testing.T) {
+	assert := assert.New(t)
+
+	dr := data.AddSubsetToDestinationRule(map[string]interface{}{
+		"name":   "all",
+		"labels": map[string]interface{}{},
+	}, data.CreateEmptyDestinationRule("test-namespace", "name", "reviews"))
+
+	vals, valid := NoDestinationChecker{
+		Namespace: "test-namespace",
+		WorkloadList: data.CreateWorkloadList("test-namespace",
+			data.CreateWorkloadListItem("reviewsv1", appVersionLabel("reviews", "v1")),
+		),
+		Services:        fakeServicesReview(),
+		DestinationRule: dr,
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
 func TestFailCrossNamespaceHost(t *testing.T) {
 	assert := assert.New(t)
 
@@ -288,7 +338,7 @@ func TestSNIProxyExample(t *testing.T) {
 
 	vals, valid := NoDestinationChecker{
 		Namespace:       "test",
-		ServiceEntries:  kubernetes.ServiceEntryHostnames([]kubernetes.IstioObject{se}),
+		ServiceEntries:  []kubernetes.IstioObject{se},
 		DestinationRule: dr,
 	}.Check()
 
@@ -308,7 +358,7 @@ func TestWildcardServiceEntry(t *testing.T) {
 
 	vals, valid := NoDestinationChecker{
 		Namespace:       "test",
-		ServiceEntries:  kubernetes.ServiceEntryHostnames([]kubernetes.IstioObject{se}),
+		ServiceEntries:  []kubernetes.IstioObject{se},
 		DestinationRule: dr,
 	}.Check()
 
@@ -316,6 +366,57 @@ func TestWildcardServiceEntry(t *testing.T) {
 	assert.Empty(vals)
 }
 
+func TestPrefixWildcardServiceEntry(t *testing.T) {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	assert := assert.New(t)
+
+	se := data.AddPortDefinitionToServiceEntry(data.CreateEmptyPortDefinition(8443, "tcp", "TCP"),
+		data.CreateEmptyMeshExternalServiceEntry("api", "prod", []string{"api-*.prod.svc.cluster.local"}))
+
+	for _, host := range []string{"api-*.prod.svc.cluster.local", "api-v1.prod.svc.cluster.local"} {
+		vals, valid := NoDestinationChecker{
+			Namespace:       "prod",
+			Namespaces:      models.Namespaces{models.Namespace{Name: "prod"}},
+			ServiceEntries:  []kubernetes.IstioObject{se},
+			DestinationRule: data.CreateEmptyDestinationRule("prod", "api", host),
+		}.Check()
+
+		assert.True(valid, host)
+		assert.Empty(vals, host)
+	}
+
+	vals, valid := NoDestinationChecker{
+		Namespace:       "prod",
+		Namespaces:      models.Namespaces{models.Namespace{Name: "prod"}},
+		ServiceEntries:  []kubernetes.IstioObject{se},
+		DestinationRule: data.CreateEmptyDestinationRule("prod", "web", "web.prod.svc.cluster.local"),
+	}.Check()
+
+	assert.False(valid)
+	assert.Len(vals, 1)
+}
+
+func TestMidWildcardServiceEntry(t *testing.T) {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	assert := assert.New(t)
+
+	se := data.AddPortDefinitionToServiceEntry(data.CreateEmptyPortDefinition(443, "https", "HTTPS"),
+		data.CreateEmptyMeshExternalServiceEntry("api", "test", []string{"api.*.example.com"}))
+
+	vals, valid := NoDestinationChecker{
+		Namespace:       "test",
+		ServiceEntries:  []kubernetes.IstioObject{se},
+		DestinationRule: data.CreateEmptyDestinationRule("test", "api-eu", "api.eu.example.com"),
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
 func TestNoLabelsInSubset(t *testing.T) {
 	assert := assert.New(t)
 
@@ -403,3 +504,39 @@ func TestValidServiceRegistry(t *testing.T) {
 	assert.False(valid)
 	assert.NotEmpty(vals)
 }
+
+func TestValidRemoteClusterServiceRegistry(t *testing.T) {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	assert := assert.New(t)
+
+	dr := data.CreateEmptyDestinationRule("test", "ratings", "ratings.bookinfo.svc.cluster.local")
+
+	localService := kubernetes.RegistryStatus{}
+	localService.Hostname = "reviews.bookinfo.svc.cluster.local"
+	remoteService := kubernetes.RegistryStatus{}
+	remoteService.Hostname = "ratings.bookinfo.svc.cluster.local"
+
+	vals, valid := NoDestinationChecker{
+		Namespace:       "test",
+		DestinationRule: dr,
+		RegistryStatus:  []*kubernetes.RegistryStatus{&localService},
+	}.Check()
+
+	assert.False(valid)
+	assert.NotEmpty(vals)
+
+	vals, valid = NoDestinationChecker{
+		Namespace:       "test",
+		DestinationRule: dr,
+		RegistryStatus:  []*kubernetes.RegistryStatus{&localService},
+		ClusterRegistryStatus: map[string][]*kubernetes.RegistryStatus{
+			"primary": {&localService},
+			"remote":  {&remoteService},
+		},
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
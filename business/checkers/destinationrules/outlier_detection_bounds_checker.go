@@ -0,0 +1,57 @@
+package destinationrules
+
+import (
+	"fmt"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/util/intutil"
+)
+
+type OutlierDetectionBoundsChecker struct {
+	DestinationRule kubernetes.IstioObject
+}
+
+// Check returns a warning for each host-level or subset outlierDetection with a maxEjectionPercent out of
+// the 0-100 range, or setting a baseEjectionTime while consecutive5xxErrors disables the only ejection trigger.
+func (o OutlierDetectionBoundsChecker) Check() ([]*models.IstioCheck, bool) {
+	validations := make([]*models.IstioCheck, 0)
+
+	if outlier, found := getOutlierDetection(o.DestinationRule.GetSpec()["trafficPolicy"]); found {
+		validations = append(validations, checkOutlierBounds(outlier, "spec/trafficPolicy/outlierDetection")...)
+	}
+
+	if subsets, ok := o.DestinationRule.GetSpec()["subsets"].([]interface{}); ok {
+		for i, subset := range subsets {
+			if subsetCasted, ok := subset.(map[string]interface{}); ok {
+				if outlier, found := getOutlierDetection(subsetCasted["trafficPolicy"]); found {
+					validations = append(validations, checkOutlierBounds(outlier, fmt.Sprintf("spec/subsets[%d]/trafficPolicy/outlierDetection", i))...)
+				}
+			}
+		}
+	}
+
+	return validations, true
+}
+
+func checkOutlierBounds(outlier map[string]interface{}, path string) []*models.IstioCheck {
+	checks := make([]*models.IstioCheck, 0)
+
+	if maxEjection, found := outlier["maxEjectionPercent"]; found {
+		if percent, err := intutil.Convert(maxEjection); err == nil && (percent < 0 || percent > 100) {
+			validation := models.Build("destinationrules.outlier.ejectpercentoutofrange", path)
+			checks = append(checks, &validation)
+		}
+	}
+
+	if _, found := outlier["baseEjectionTime"]; found {
+		consecutive5xx, found5xx := outlier["consecutive5xxErrors"]
+		consecutiveGateway, _ := intutil.Convert(outlier["consecutiveGatewayErrors"])
+		if value, err := intutil.Convert(consecutive5xx); found5xx && err == nil && value == 0 && consecutiveGateway <= 0 {
+			validation := models.Build("destinationrules.outlier.noejectiontrigger", path)
+			checks = append(checks, &validation)
+		}
+	}
+
+	return checks
+}
@@ -0,0 +1,86 @@
+package destinationrules
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestOutlierDetectionEjectPercentOutOfRange(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	dr := data.AddTrafficPolicyToDestinationRule(map[string]interface{}{
+		"outlierDetection": map[string]interface{}{"maxEjectionPercent": uint64(150)},
+	}, data.CreateEmptyDestinationRule("bookinfo", "reviews", "reviews"))
+
+	vals, valid := OutlierDetectionBoundsChecker{DestinationRule: dr}.Check()
+
+	assert.True(valid)
+	assert.Len(vals, 1)
+	assert.Equal(models.WarningSeverity, vals[0].Severity)
+	assert.Equal("spec/trafficPolicy/outlierDetection", vals[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("destinationrules.outlier.ejectpercentoutofrange", vals[0]))
+}
+
+func TestOutlierDetectionEjectPercentInRange(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	dr := data.AddTrafficPolicyToDestinationRule(map[string]interface{}{
+		"outlierDetection": map[string]interface{}{"maxEjectionPercent": uint64(50)},
+	}, data.CreateEmptyDestinationRule("bookinfo", "reviews", "reviews"))
+
+	vals, valid := OutlierDetectionBoundsChecker{DestinationRule: dr}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestOutlierDetectionSubsetEjectPercentOutOfRange(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	dr := outlierDetectionDestinationRule(
+		map[string]interface{}{"maxEjectionPercent": uint64(50)},
+		map[string]interface{}{"maxEjectionPercent": uint64(150)},
+	)
+
+	vals, valid := OutlierDetectionBoundsChecker{DestinationRule: dr}.Check()
+
+	assert.True(valid)
+	assert.Len(vals, 1)
+	assert.Equal("spec/subsets[0]/trafficPolicy/outlierDetection", vals[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("destinationrules.outlier.ejectpercentoutofrange", vals[0]))
+}
+
+func TestOutlierDetectionNoEjectionTrigger(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	dr := data.AddTrafficPolicyToDestinationRule(map[string]interface{}{
+		"outlierDetection": map[string]interface{}{
+			"consecutive5xxErrors": uint64(0),
+			"baseEjectionTime":     "30s",
+		},
+	}, data.CreateEmptyDestinationRule("bookinfo", "reviews", "reviews"))
+
+	vals, valid := OutlierDetectionBoundsChecker{DestinationRule: dr}.Check()
+
+	assert.True(valid)
+	assert.Len(vals, 1)
+	assert.Equal("spec/trafficPolicy/outlierDetection", vals[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("destinationrules.outlier.noejectiontrigger", vals[0]))
+
+	dr.GetSpec()["trafficPolicy"].(map[string]interface{})["outlierDetection"].(map[string]interface{})["consecutiveGatewayErrors"] = uint64(3)
+
+	vals, valid = OutlierDetectionBoundsChecker{DestinationRule: dr}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
@@ -0,0 +1,89 @@
+package destinationrules
+
+import (
+	"fmt"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/util/intutil"
+)
+
+// Istio defaults applied when the outlierDetection fields are not set
+const (
+	defaultConsecutive5xxErrors     = 5
+	defaultConsecutiveGatewayErrors = 0
+)
+
+type OutlierDetectionChecker struct {
+	DestinationRule kubernetes.IstioObject
+}
+
+// Check returns an informational check for each subset whose outlierDetection enables ejection
+// while the host-level outlierDetection disables it, or vice versa.
+// Subsets without their own outlierDetection inherit the host-level one and are not reported.
+func (o OutlierDetectionChecker) Check() ([]*models.IstioCheck, bool) {
+	validations := make([]*models.IstioCheck, 0)
+
+	hostOutlier, found := getOutlierDetection(o.DestinationRule.GetSpec()["trafficPolicy"])
+	if !found {
+		return validations, true
+	}
+	hostEjection := isEjectionEnabled(hostOutlier)
+
+	if subsets, ok := o.DestinationRule.GetSpec()["subsets"].([]interface{}); ok {
+		for i, subset := range subsets {
+			if subsetCasted, ok := subset.(map[string]interface{}); ok {
+				if subsetOutlier, found := getOutlierDetection(subsetCasted["trafficPolicy"]); found {
+					if isEjectionEnabled(subsetOutlier) != hostEjection {
+						validation := models.Build("destinationrules.trafficpolicy.outlierdetectionmismatch",
+							fmt.Sprintf("spec/subsets[%d]/trafficPolicy/outlierDetection", i))
+						validations = append(validations, &validation)
+					}
+				}
+			}
+		}
+	}
+
+	return validations, true
+}
+
+func getOutlierDetection(trafficPolicy interface{}) (map[string]interface{}, bool) {
+	if trafficCasted, ok := trafficPolicy.(map[string]interface{}); ok {
+		if outlier, found := trafficCasted["outlierDetection"]; found {
+			if outlierCasted, ok := outlier.(map[string]interface{}); ok {
+				return outlierCasted, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// isEjectionEnabled returns true when the outlierDetection settings can actually eject hosts
+func isEjectionEnabled(outlier map[string]interface{}) bool {
+	if maxEjection, found := outlier["maxEjectionPercent"]; found {
+		if percent, err := intutil.Convert(maxEjection); err == nil && percent == 0 {
+			return false
+		}
+	}
+
+	consecutive5xx := defaultConsecutive5xxErrors
+	if errors, found := outlier["consecutive5xxErrors"]; found {
+		if value, err := intutil.Convert(errors); err == nil {
+			consecutive5xx = value
+		}
+	} else if errors, found := outlier["consecutiveErrors"]; found {
+		// consecutiveErrors is deprecated but still honored by Istio
+		if value, err := intutil.Convert(errors); err == nil {
+			consecutive5xx = value
+		}
+	}
+
+	consecutiveGateway := defaultConsecutiveGatewayErrors
+	if errors, found := outlier["consecutiveGatewayErrors"]; found {
+		if value, err := intutil.Convert(errors); err == nil {
+			consecutiveGateway = value
+		}
+	}
+
+	return consecutive5xx > 0 || consecutiveGateway > 0
+}
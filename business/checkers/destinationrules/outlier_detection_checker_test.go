@@ -0,0 +1,87 @@
+package destinationrules
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestOutlierDetectionSubsetDiffers(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	dr := outlierDetectionDestinationRule(
+		map[string]interface{}{"consecutive5xxErrors": uint64(3)},
+		map[string]interface{}{"consecutive5xxErrors": uint64(0)},
+	)
+
+	vals, valid := OutlierDetectionChecker{DestinationRule: dr}.Check()
+
+	assert.True(valid)
+	assert.Len(vals, 1)
+	assert.Equal(models.InfoSeverity, vals[0].Severity)
+	assert.Equal("spec/subsets[0]/trafficPolicy/outlierDetection", vals[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("destinationrules.trafficpolicy.outlierdetectionmismatch", vals[0]))
+}
+
+func TestOutlierDetectionSubsetEnablesEjection(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	dr := outlierDetectionDestinationRule(
+		map[string]interface{}{"maxEjectionPercent": uint64(0)},
+		map[string]interface{}{"consecutiveGatewayErrors": uint64(2)},
+	)
+
+	vals, valid := OutlierDetectionChecker{DestinationRule: dr}.Check()
+
+	assert.True(valid)
+	assert.Len(vals, 1)
+	assert.Equal("spec/subsets[0]/trafficPolicy/outlierDetection", vals[0].Path)
+}
+
+func TestOutlierDetectionSubsetSameBehaviour(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	dr := outlierDetectionDestinationRule(
+		map[string]interface{}{"consecutive5xxErrors": uint64(3)},
+		map[string]interface{}{"consecutive5xxErrors": uint64(10)},
+	)
+
+	vals, valid := OutlierDetectionChecker{DestinationRule: dr}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestOutlierDetectionSubsetInherited(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	dr := data.AddTrafficPolicyToDestinationRule(map[string]interface{}{
+		"outlierDetection": map[string]interface{}{"consecutive5xxErrors": uint64(3)},
+	}, data.CreateTestDestinationRule("bookinfo", "reviews", "reviews"))
+
+	vals, valid := OutlierDetectionChecker{DestinationRule: dr}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func outlierDetectionDestinationRule(hostOutlier, subsetOutlier map[string]interface{}) kubernetes.IstioObject {
+	subset := data.CreateSubset("v1", "v1")
+	subset["trafficPolicy"] = map[string]interface{}{
+		"outlierDetection": subsetOutlier,
+	}
+
+	return data.AddTrafficPolicyToDestinationRule(map[string]interface{}{
+		"outlierDetection": hostOutlier,
+	}, data.AddSubsetToDestinationRule(subset, data.CreateEmptyDestinationRule("bookinfo", "reviews", "reviews")))
+}
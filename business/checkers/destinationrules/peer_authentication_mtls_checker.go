@@ -0,0 +1,48 @@
+package destinationrules
+
+import (
+	"strings"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+type PeerAuthenticationMTLSChecker struct {
+	DestinationRule     kubernetes.IstioObject
+	Namespaces          []string
+	PeerAuthentications []kubernetes.IstioObject
+}
+
+// Check returns an error when the DestinationRule disables TLS for a service host
+// while a namespace-wide PeerAuthentication of the host namespace enforces STRICT mTLS.
+// Namespace-wide and mesh-wide DestinationRules are covered by the Disabled*MTLSCheckers.
+func (m PeerAuthenticationMTLSChecker) Check() ([]*models.IstioCheck, bool) {
+	validations := make([]*models.IstioCheck, 0)
+
+	host, ok := m.DestinationRule.GetSpec()["host"].(string)
+	if !ok || strings.HasPrefix(host, "*") {
+		return validations, true
+	}
+
+	if _, mode := kubernetes.DestinationRuleHasMTLSEnabled(m.DestinationRule); mode != "DISABLE" {
+		return validations, true
+	}
+
+	meta := m.DestinationRule.GetObjectMeta()
+	fqdn := kubernetes.GetHost(host, meta.Namespace, meta.ClusterName, m.Namespaces)
+	if !fqdn.CompleteInput {
+		return validations, true
+	}
+
+	for _, pa := range m.PeerAuthentications {
+		if pa.GetObjectMeta().Namespace != fqdn.Namespace {
+			continue
+		}
+		if _, mode := kubernetes.PeerAuthnHasMTLSEnabled(pa); mode == "STRICT" {
+			check := models.Build("destinationrules.mtls.policymtlsenabled", "spec/trafficPolicy/tls/mode")
+			return append(validations, &check), false
+		}
+	}
+
+	return validations, true
+}
@@ -0,0 +1,80 @@
+package destinationrules
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+// Context: DestinationRule disabling mTLS for a service host
+// Context: PeerAuthn ns-wide in strict mode
+// It returns an error
+func TestDRHostDisablingTLSPolicyStrict(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := PeerAuthenticationMTLSChecker{
+		DestinationRule: disabledMTLSDestinationRule("reviews"),
+		Namespaces:      []string{"bookinfo"},
+		PeerAuthentications: []kubernetes.IstioObject{
+			data.CreateEmptyPeerAuthentication("default", "bookinfo", data.CreateMTLS("STRICT")),
+		},
+	}.Check()
+
+	assert.False(valid)
+	assert.Len(vals, 1)
+	assert.Equal(models.ErrorSeverity, vals[0].Severity)
+	assert.Equal("spec/trafficPolicy/tls/mode", vals[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("destinationrules.mtls.policymtlsenabled", vals[0]))
+}
+
+// Context: DestinationRule disabling mTLS for a service host
+// Context: PeerAuthn ns-wide in permissive mode and PeerAuthn in strict mode for some workloads
+// It doesn't return any validation
+func TestDRHostDisablingTLSPolicyPermissive(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := PeerAuthenticationMTLSChecker{
+		DestinationRule: disabledMTLSDestinationRule("reviews.bookinfo.svc.cluster.local"),
+		Namespaces:      []string{"bookinfo"},
+		PeerAuthentications: []kubernetes.IstioObject{
+			data.CreateEmptyPeerAuthentication("default", "bookinfo", data.CreateMTLS("PERMISSIVE")),
+			data.AddSelectorToPeerAuthn(data.CreateOneLabelSelector("ratings"),
+				data.CreateEmptyPeerAuthentication("ratings", "bookinfo", data.CreateMTLS("STRICT"))),
+		},
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+// Context: DestinationRule disabling mTLS for a service host of another namespace
+// Context: PeerAuthn ns-wide in strict mode
+// It doesn't return any validation
+func TestDRHostOtherNamespaceDisablingTLSPolicyStrict(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := PeerAuthenticationMTLSChecker{
+		DestinationRule: disabledMTLSDestinationRule("reviews.other.svc.cluster.local"),
+		Namespaces:      []string{"bookinfo", "other"},
+		PeerAuthentications: []kubernetes.IstioObject{
+			data.CreateEmptyPeerAuthentication("default", "bookinfo", data.CreateMTLS("STRICT")),
+		},
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func disabledMTLSDestinationRule(host string) kubernetes.IstioObject {
+	return data.AddTrafficPolicyToDestinationRule(data.CreateDisabledMTLSTrafficPolicyForDestinationRules(),
+		data.CreateEmptyDestinationRule("bookinfo", "disable-mtls", host))
+}
@@ -0,0 +1,56 @@
+package destinationrules
+
+import (
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+// Istio resolution applied when a ServiceEntry doesn't set it
+const defaultServiceEntryResolution = "NONE"
+
+type ServiceEntryResolutionChecker struct {
+	DestinationRule kubernetes.IstioObject
+	ServiceEntries  []kubernetes.IstioObject
+}
+
+// Check returns a warning when the DestinationRule host is covered by several ServiceEntries
+// that don't agree on the resolution, as the effective behavior for that host is ambiguous.
+func (s ServiceEntryResolutionChecker) Check() ([]*models.IstioCheck, bool) {
+	validations := make([]*models.IstioCheck, 0)
+
+	host, ok := s.DestinationRule.GetSpec()["host"].(string)
+	if !ok {
+		return validations, true
+	}
+
+	resolutions := make(map[string]bool)
+	for _, se := range s.ServiceEntries {
+		if serviceEntryCoversHost(se, host) {
+			resolution := defaultServiceEntryResolution
+			if seResolution, ok := se.GetSpec()["resolution"].(string); ok && seResolution != "" {
+				resolution = seResolution
+			}
+			resolutions[resolution] = true
+		}
+	}
+
+	if len(resolutions) > 1 {
+		validation := models.Build("destinationrules.serviceentries.resolutionconflict", "spec/host")
+		validations = append(validations, &validation)
+	}
+
+	return validations, true
+}
+
+func serviceEntryCoversHost(se kubernetes.IstioObject, host string) bool {
+	if hosts, ok := se.GetSpec()["hosts"].([]interface{}); ok {
+		for _, h := range hosts {
+			if seHost, ok := h.(string); ok {
+				if seHost == host || kubernetes.HostWithinWildcardHost(host, seHost) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,70 @@
+package destinationrules
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestSingleServiceEntryResolution(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := ServiceEntryResolutionChecker{
+		DestinationRule: data.CreateEmptyDestinationRule("test", "wikipedia", "en.wikipedia.org"),
+		ServiceEntries: []kubernetes.IstioObject{
+			resolutionServiceEntry("wikipedia", []string{"en.wikipedia.org"}, "DNS"),
+		},
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestConsistentServiceEntriesResolution(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := ServiceEntryResolutionChecker{
+		DestinationRule: data.CreateEmptyDestinationRule("test", "wikipedia", "en.wikipedia.org"),
+		ServiceEntries: []kubernetes.IstioObject{
+			resolutionServiceEntry("wikipedia", []string{"en.wikipedia.org"}, "DNS"),
+			resolutionServiceEntry("wikipedia-all", []string{"*.wikipedia.org"}, "DNS"),
+		},
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestConflictingServiceEntriesResolution(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := ServiceEntryResolutionChecker{
+		DestinationRule: data.CreateEmptyDestinationRule("test", "wikipedia", "en.wikipedia.org"),
+		ServiceEntries: []kubernetes.IstioObject{
+			resolutionServiceEntry("wikipedia", []string{"en.wikipedia.org"}, "DNS"),
+			resolutionServiceEntry("wikipedia-all", []string{"*.wikipedia.org"}, "NONE"),
+			resolutionServiceEntry("other", []string{"*.example.org"}, "STATIC"),
+		},
+	}.Check()
+
+	assert.True(valid)
+	assert.Len(vals, 1)
+	assert.Equal(models.WarningSeverity, vals[0].Severity)
+	assert.Equal("spec/host", vals[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("destinationrules.serviceentries.resolutionconflict", vals[0]))
+}
+
+func resolutionServiceEntry(name string, hosts []string, resolution string) kubernetes.IstioObject {
+	se := data.CreateEmptyMeshExternalServiceEntry(name, "test", hosts)
+	se.GetSpec()["resolution"] = resolution
+	return se
+}
@@ -0,0 +1,71 @@
+package destinationrules
+
+import (
+	"fmt"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+type ServiceEntryTLSModeChecker struct {
+	DestinationRule kubernetes.IstioObject
+	ServiceEntries  []kubernetes.IstioObject
+}
+
+// Check returns a warning for each host-level or subset tls mode set to ISTIO_MUTUAL when the host is declared
+// by a ServiceEntry with TLS or HTTPS ports, as the external endpoint doesn't take the Istio certificates.
+func (s ServiceEntryTLSModeChecker) Check() ([]*models.IstioCheck, bool) {
+	validations := make([]*models.IstioCheck, 0)
+
+	host, ok := s.DestinationRule.GetSpec()["host"].(string)
+	if !ok || !s.hasTLSPorts(host) {
+		return validations, true
+	}
+
+	if isIstioMutual(s.DestinationRule.GetSpec()["trafficPolicy"]) {
+		validation := models.Build("destinationrules.trafficpolicy.tlsmodeistiomutual", "spec/trafficPolicy/tls/mode")
+		validations = append(validations, &validation)
+	}
+
+	if subsets, ok := s.DestinationRule.GetSpec()["subsets"].([]interface{}); ok {
+		for i, subset := range subsets {
+			if subsetCasted, ok := subset.(map[string]interface{}); ok && isIstioMutual(subsetCasted["trafficPolicy"]) {
+				validation := models.Build("destinationrules.trafficpolicy.tlsmodeistiomutual", fmt.Sprintf("spec/subsets[%d]/trafficPolicy/tls/mode", i))
+				validations = append(validations, &validation)
+			}
+		}
+	}
+
+	return validations, true
+}
+
+// hasTLSPorts returns true when a MESH_EXTERNAL ServiceEntry covering the host declares TLS or HTTPS ports.
+// MESH_INTERNAL entries point to workloads with sidecars, which do take the Istio certificates.
+func (s ServiceEntryTLSModeChecker) hasTLSPorts(host string) bool {
+	for _, se := range s.ServiceEntries {
+		if location, ok := se.GetSpec()["location"].(string); ok && location == "MESH_INTERNAL" {
+			continue
+		}
+		if !serviceEntryCoversHost(se, host) {
+			continue
+		}
+		// Both TLS and HTTPS ports map to the tls protocol
+		for _, protocols := range kubernetes.ServiceEntryHostnames([]kubernetes.IstioObject{se}) {
+			for _, protocol := range protocols {
+				if protocol == "tls" {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+func isIstioMutual(trafficPolicy interface{}) bool {
+	if trafficCasted, ok := trafficPolicy.(map[string]interface{}); ok {
+		if tls, ok := trafficCasted["tls"].(map[string]interface{}); ok {
+			return tls["mode"] == "ISTIO_MUTUAL"
+		}
+	}
+	return false
+}
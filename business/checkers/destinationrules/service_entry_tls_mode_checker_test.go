@@ -0,0 +1,87 @@
+package destinationrules
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestHTTPSServiceEntryIstioMutual(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := ServiceEntryTLSModeChecker{
+		DestinationRule: tlsModeDestinationRule("ISTIO_MUTUAL"),
+		ServiceEntries:  []kubernetes.IstioObject{httpsServiceEntry()},
+	}.Check()
+
+	assert.True(valid)
+	assert.Len(vals, 1)
+	assert.Equal(models.WarningSeverity, vals[0].Severity)
+	assert.Equal("spec/trafficPolicy/tls/mode", vals[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("destinationrules.trafficpolicy.tlsmodeistiomutual", vals[0]))
+}
+
+func TestHTTPSServiceEntrySimple(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := ServiceEntryTLSModeChecker{
+		DestinationRule: tlsModeDestinationRule("SIMPLE"),
+		ServiceEntries:  []kubernetes.IstioObject{httpsServiceEntry()},
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestHTTPSPortOfAnotherServiceEntry(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	httpSE := data.AddPortDefinitionToServiceEntry(data.CreateEmptyPortDefinition(80, "http", "HTTP"),
+		data.CreateEmptyMeshExternalServiceEntry("wikipedia", "bookinfo", []string{"www.wikipedia.org"}))
+	httpsSE := data.AddPortDefinitionToServiceEntry(data.CreateEmptyPortDefinition(443, "https", "HTTPS"),
+		data.CreateEmptyMeshExternalServiceEntry("google", "bookinfo", []string{"www.google.com"}))
+
+	vals, valid := ServiceEntryTLSModeChecker{
+		DestinationRule: tlsModeDestinationRule("ISTIO_MUTUAL"),
+		ServiceEntries:  []kubernetes.IstioObject{httpSE, httpsSE},
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestHTTPSMeshInternalServiceEntry(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	se := httpsServiceEntry()
+	se.GetSpec()["location"] = "MESH_INTERNAL"
+
+	vals, valid := ServiceEntryTLSModeChecker{
+		DestinationRule: tlsModeDestinationRule("ISTIO_MUTUAL"),
+		ServiceEntries:  []kubernetes.IstioObject{se},
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func tlsModeDestinationRule(mode string) kubernetes.IstioObject {
+	return data.AddTrafficPolicyToDestinationRule(map[string]interface{}{
+		"tls": map[string]interface{}{"mode": mode},
+	}, data.CreateEmptyDestinationRule("bookinfo", "wikipedia", "www.wikipedia.org"))
+}
+
+func httpsServiceEntry() kubernetes.IstioObject {
+	return data.AddPortDefinitionToServiceEntry(data.CreateEmptyPortDefinition(443, "https", "HTTPS"),
+		data.CreateEmptyMeshExternalServiceEntry("wikipedia", "bookinfo", []string{"www.wikipedia.org"}))
+}
@@ -0,0 +1,100 @@
+package destinationrules
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	core_v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+type SubsetLabelCoverageChecker struct {
+	DestinationRule kubernetes.IstioObject
+	Namespaces      []string
+	Services        []core_v1.Service
+	WorkloadList    models.WorkloadList
+}
+
+// Check returns a warning for each subset using label keys that none of the workloads selected
+// by the host Service carry, as such a subset can never match any pod of the service.
+func (s SubsetLabelCoverageChecker) Check() ([]*models.IstioCheck, bool) {
+	validations := make([]*models.IstioCheck, 0)
+
+	host, ok := s.DestinationRule.GetSpec()["host"].(string)
+	if !ok {
+		return validations, true
+	}
+	subsets, ok := s.DestinationRule.GetSpec()["subsets"].([]interface{})
+	if !ok {
+		return validations, true
+	}
+
+	meta := s.DestinationRule.GetObjectMeta()
+	drHost := kubernetes.GetHost(host, meta.Namespace, meta.ClusterName, s.Namespaces)
+	if !drHost.CompleteInput || drHost.Namespace != s.WorkloadList.Namespace.Name {
+		return validations, true
+	}
+
+	labelKeys, found := s.serviceLabelKeys(drHost)
+	if !found {
+		// No workload is selected by the service, NoDestinationChecker already reports it
+		return validations, true
+	}
+
+	for i, subset := range subsets {
+		subsetDef, ok := subset.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		subsetLabels, ok := subsetDef["labels"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		missing := make([]string, 0)
+		for key := range subsetLabels {
+			if !labelKeys[key] {
+				missing = append(missing, key)
+			}
+		}
+		if len(missing) > 0 {
+			sort.Strings(missing)
+			validation := models.Build("destinationrules.subset.labelnotfound", fmt.Sprintf("spec/subsets[%d]/labels", i))
+			validation.Remediation = fmt.Sprintf("No workload of service %s has the label keys %s, fix the subset labels", drHost.Service, strings.Join(missing, ","))
+			validations = append(validations, &validation)
+		}
+	}
+
+	return validations, true
+}
+
+// serviceLabelKeys returns the union of the label keys of the workloads selected by the host Service,
+// and false when the service is unknown or selects no workload
+func (s SubsetLabelCoverageChecker) serviceLabelKeys(host kubernetes.Host) (map[string]bool, bool) {
+	for _, svc := range s.Services {
+		if svc.Name != host.Service || svc.Namespace != host.Namespace {
+			continue
+		}
+		if len(svc.Spec.Selector) == 0 {
+			return nil, false
+		}
+
+		selector := labels.SelectorFromSet(labels.Set(svc.Spec.Selector))
+		labelKeys := make(map[string]bool)
+		matched := false
+		for _, wl := range s.WorkloadList.Workloads {
+			if !selector.Matches(labels.Set(wl.Labels)) {
+				continue
+			}
+			matched = true
+			for key := range wl.Labels {
+				labelKeys[key] = true
+			}
+		}
+		return labelKeys, matched
+	}
+	return nil, false
+}
@@ -0,0 +1,83 @@
+package destinationrules
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	core_v1 "k8s.io/api/core/v1"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestSubsetLabelsCovered(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := SubsetLabelCoverageChecker{
+		DestinationRule: data.CreateTestDestinationRule("bookinfo", "reviews", "reviews"),
+		Namespaces:      []string{"bookinfo"},
+		Services:        []core_v1.Service{warmupService()},
+		WorkloadList: data.CreateWorkloadList("bookinfo",
+			data.CreateWorkloadListItem("reviews-v1", appVersionLabel("reviews", "v1")),
+			data.CreateWorkloadListItem("reviews-v2", appVersionLabel("reviews", "v2")),
+		),
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestSubsetLabelNotFound(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	dr := data.AddSubsetToDestinationRule(map[string]interface{}{
+		"name": "canary",
+		"labels": map[string]interface{}{
+			"version": "v2",
+			"track":   "canary",
+		},
+	}, data.CreateTestDestinationRule("bookinfo", "reviews", "reviews"))
+
+	vals, valid := SubsetLabelCoverageChecker{
+		DestinationRule: dr,
+		Namespaces:      []string{"bookinfo"},
+		Services:        []core_v1.Service{warmupService()},
+		WorkloadList: data.CreateWorkloadList("bookinfo",
+			data.CreateWorkloadListItem("reviews-v1", appVersionLabel("reviews", "v1")),
+			data.CreateWorkloadListItem("reviews-v2", appVersionLabel("reviews", "v2")),
+		),
+	}.Check()
+
+	assert.True(valid)
+	assert.Len(vals, 1)
+	assert.Equal(models.WarningSeverity, vals[0].Severity)
+	assert.Equal("spec/subsets[2]/labels", vals[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("destinationrules.subset.labelnotfound", vals[0]))
+	assert.Equal("No workload of service reviews has the label keys track, fix the subset labels", vals[0].Remediation)
+}
+
+func TestSubsetEmptyLabels(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	dr := data.AddSubsetToDestinationRule(map[string]interface{}{
+		"name":   "all",
+		"labels": map[string]interface{}{},
+	}, data.CreateNoLabelsDestinationRule("bookinfo", "reviews", "reviews"))
+
+	vals, valid := SubsetLabelCoverageChecker{
+		DestinationRule: dr,
+		Namespaces:      []string{"bookinfo"},
+		Services:        []core_v1.Service{warmupService()},
+		WorkloadList: data.CreateWorkloadList("bookinfo",
+			data.CreateWorkloadListItem("reviews-v1", appVersionLabel("reviews", "v1")),
+		),
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
@@ -0,0 +1,80 @@
+package destinationrules
+
+import (
+	"fmt"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+type VersionLabelChecker struct {
+	DestinationRule kubernetes.IstioObject
+	Namespaces      []string
+	WorkloadList    models.WorkloadList
+}
+
+// Check returns a warning for each subset keyed off the version label when some of the workloads
+// of the host app carry that label and others don't, as requests to the unlabeled ones can't be routed by subset.
+func (v VersionLabelChecker) Check() ([]*models.IstioCheck, bool) {
+	validations := make([]*models.IstioCheck, 0)
+
+	host, ok := v.DestinationRule.GetSpec()["host"].(string)
+	if !ok {
+		return validations, true
+	}
+	subsets, ok := v.DestinationRule.GetSpec()["subsets"].([]interface{})
+	if !ok {
+		return validations, true
+	}
+
+	meta := v.DestinationRule.GetObjectMeta()
+	drHost := kubernetes.GetHost(host, meta.Namespace, meta.ClusterName, v.Namespaces)
+	if !drHost.CompleteInput || drHost.Namespace != v.WorkloadList.Namespace.Name {
+		return validations, true
+	}
+
+	if !v.hasPartiallyVersionedWorkloads(drHost.Service) {
+		return validations, true
+	}
+
+	versionLabel := config.Get().IstioLabels.VersionLabelName
+	for i, subset := range subsets {
+		subsetDef, ok := subset.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if subsetLabels, ok := subsetDef["labels"].(map[string]interface{}); ok {
+			if _, found := subsetLabels[versionLabel]; found {
+				validation := models.Build("destinationrules.subset.inconsistentversionlabel", fmt.Sprintf("spec/subsets[%d]/labels", i))
+				validations = append(validations, &validation)
+			}
+		}
+	}
+
+	return validations, true
+}
+
+// hasPartiallyVersionedWorkloads returns true when the app has versioned workloads along with workloads without version label
+func (v VersionLabelChecker) hasPartiallyVersionedWorkloads(app string) bool {
+	cfg := config.Get()
+
+	appWorkloads := models.WorkloadList{Namespace: v.WorkloadList.Namespace}
+	for _, wl := range v.WorkloadList.Workloads {
+		if wl.Labels[cfg.IstioLabels.AppLabelName] == app {
+			appWorkloads.Workloads = append(appWorkloads.Workloads, wl)
+		}
+	}
+
+	if len(appWorkloads.GroupByAppVersion()[app]) == 0 {
+		// No workload is versioned, subsets won't match any of them
+		return false
+	}
+
+	for _, wl := range appWorkloads.Workloads {
+		if _, found := wl.Labels[cfg.IstioLabels.VersionLabelName]; !found {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,50 @@
+package destinationrules
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestConsistentVersionLabels(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := VersionLabelChecker{
+		DestinationRule: data.CreateTestDestinationRule("test", "reviews", "reviews"),
+		Namespaces:      []string{"test"},
+		WorkloadList: data.CreateWorkloadList("test",
+			data.CreateWorkloadListItem("reviews-v1", appVersionLabel("reviews", "v1")),
+			data.CreateWorkloadListItem("reviews-v2", appVersionLabel("reviews", "v2")),
+		),
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestPartiallyVersionedWorkloads(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := VersionLabelChecker{
+		DestinationRule: data.CreateTestDestinationRule("test", "reviews", "reviews"),
+		Namespaces:      []string{"test"},
+		WorkloadList: data.CreateWorkloadList("test",
+			data.CreateWorkloadListItem("reviews-v1", appVersionLabel("reviews", "v1")),
+			data.CreateWorkloadListItem("reviews", map[string]string{"app": "reviews"}),
+		),
+	}.Check()
+
+	assert.True(valid)
+	assert.Len(vals, 2)
+	assert.Equal(models.WarningSeverity, vals[0].Severity)
+	assert.Equal("spec/subsets[0]/labels", vals[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("destinationrules.subset.inconsistentversionlabel", vals[0]))
+	assert.Equal("spec/subsets[1]/labels", vals[1].Path)
+}
@@ -0,0 +1,73 @@
+package destinationrules
+
+import (
+	core_v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+type WarmupSingleEndpointChecker struct {
+	DestinationRule kubernetes.IstioObject
+	Namespaces      []string
+	Services        []core_v1.Service
+	WorkloadList    models.WorkloadList
+}
+
+// Check returns an informational check when the top level loadBalancer sets warmupDurationSecs
+// but the host Service is backed by a single pod, as there are no other endpoints to warm up against.
+func (w WarmupSingleEndpointChecker) Check() ([]*models.IstioCheck, bool) {
+	validations := make([]*models.IstioCheck, 0)
+
+	dr := models.DestinationRule{}
+	dr.Parse(w.DestinationRule)
+
+	loadBalancer, found := dr.LoadBalancer("")
+	if !found {
+		return validations, true
+	}
+	if _, found := loadBalancer["warmupDurationSecs"]; !found {
+		return validations, true
+	}
+
+	host, ok := dr.Spec.Host.(string)
+	if !ok {
+		return validations, true
+	}
+
+	meta := w.DestinationRule.GetObjectMeta()
+	drHost := kubernetes.GetHost(host, meta.Namespace, meta.ClusterName, w.Namespaces)
+	if !drHost.CompleteInput || drHost.Namespace != meta.Namespace {
+		return validations, true
+	}
+
+	for _, svc := range w.Services {
+		if svc.Name != drHost.Service || svc.Namespace != drHost.Namespace {
+			continue
+		}
+		if w.countEndpoints(svc) == 1 {
+			validation := models.Build("destinationrules.trafficpolicy.warmupsingleendpoint", "spec/trafficPolicy/loadBalancer")
+			validations = append(validations, &validation)
+		}
+		break
+	}
+
+	return validations, true
+}
+
+// countEndpoints returns the number of pods of the workloads selected by the service
+func (w WarmupSingleEndpointChecker) countEndpoints(svc core_v1.Service) int {
+	if len(svc.Spec.Selector) == 0 {
+		return 0
+	}
+
+	selector := labels.SelectorFromSet(labels.Set(svc.Spec.Selector))
+	endpoints := 0
+	for _, wl := range w.WorkloadList.Workloads {
+		if selector.Matches(labels.Set(wl.Labels)) {
+			endpoints += wl.PodCount
+		}
+	}
+	return endpoints
+}
@@ -0,0 +1,99 @@
+package destinationrules
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	core_v1 "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestWarmupSingleEndpoint(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := WarmupSingleEndpointChecker{
+		DestinationRule: warmupDestinationRule(),
+		Namespaces:      []string{"bookinfo"},
+		Services:        []core_v1.Service{warmupService()},
+		WorkloadList:    warmupWorkloads(1),
+	}.Check()
+
+	assert.True(valid)
+	assert.Len(vals, 1)
+	assert.Equal(models.InfoSeverity, vals[0].Severity)
+	assert.Equal("spec/trafficPolicy/loadBalancer", vals[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("destinationrules.trafficpolicy.warmupsingleendpoint", vals[0]))
+}
+
+func TestWarmupMultipleEndpoints(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := WarmupSingleEndpointChecker{
+		DestinationRule: warmupDestinationRule(),
+		Namespaces:      []string{"bookinfo"},
+		Services:        []core_v1.Service{warmupService()},
+		WorkloadList:    warmupWorkloads(1, 2),
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestNoWarmupSingleEndpoint(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	dr := data.AddTrafficPolicyToDestinationRule(map[string]interface{}{
+		"loadBalancer": map[string]interface{}{"simple": "LEAST_REQUEST"},
+	}, data.CreateEmptyDestinationRule("bookinfo", "reviews", "reviews"))
+
+	vals, valid := WarmupSingleEndpointChecker{
+		DestinationRule: dr,
+		Namespaces:      []string{"bookinfo"},
+		Services:        []core_v1.Service{warmupService()},
+		WorkloadList:    warmupWorkloads(1),
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func warmupDestinationRule() kubernetes.IstioObject {
+	return data.AddTrafficPolicyToDestinationRule(map[string]interface{}{
+		"loadBalancer": map[string]interface{}{
+			"simple":             "ROUND_ROBIN",
+			"warmupDurationSecs": "60s",
+		},
+	}, data.CreateEmptyDestinationRule("bookinfo", "reviews", "reviews"))
+}
+
+func warmupService() core_v1.Service {
+	return core_v1.Service{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name:      "reviews",
+			Namespace: "bookinfo",
+		},
+		Spec: core_v1.ServiceSpec{
+			Selector: map[string]string{"app": "reviews"},
+		},
+	}
+}
+
+func warmupWorkloads(podCounts ...int) models.WorkloadList {
+	workloads := models.WorkloadList{Namespace: models.Namespace{Name: "bookinfo"}}
+	for _, podCount := range podCounts {
+		workloads.Workloads = append(workloads.Workloads, models.WorkloadListItem{
+			Labels:   map[string]string{"app": "reviews"},
+			PodCount: podCount,
+		})
+	}
+	return workloads
+}
@@ -0,0 +1,98 @@
+package envoyfilters
+
+import (
+	"fmt"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+// matchTypes maps each applyTo value to the match type that can select its objects
+var matchTypes = map[string]string{
+	"LISTENER":            "listener",
+	"FILTER_CHAIN":        "listener",
+	"NETWORK_FILTER":      "listener",
+	"HTTP_FILTER":         "listener",
+	"ROUTE_CONFIGURATION": "routeConfiguration",
+	"VIRTUAL_HOST":        "routeConfiguration",
+	"HTTP_ROUTE":          "routeConfiguration",
+	"CLUSTER":             "cluster",
+	"LISTENER_FILTER":     "listener",
+}
+
+// referenceOperations need a reference filter to operate on. INSERT_BEFORE and INSERT_AFTER
+// without a reference filter insert at the beginning or at the end of the filter chain.
+var referenceOperations = map[string]bool{
+	"REPLACE": true,
+	"MERGE":   true,
+	"REMOVE":  true,
+}
+
+type ApplyToMatchChecker struct {
+	EnvoyFilter kubernetes.IstioObject
+}
+
+// Check returns a warning for each config patch whose match can't select the objects it applies to:
+// a listener, routeConfiguration or cluster match of a different kind than the applyTo objects, or
+// a filter patch replacing, merging or removing a filter that the match doesn't name.
+func (a ApplyToMatchChecker) Check() ([]*models.IstioCheck, bool) {
+	validations := make([]*models.IstioCheck, 0)
+
+	configPatches, ok := a.EnvoyFilter.GetSpec()["configPatches"].([]interface{})
+	if !ok {
+		return validations, true
+	}
+
+	for i, configPatch := range configPatches {
+		patchDef, ok := configPatch.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		applyTo, _ := patchDef["applyTo"].(string)
+		match, _ := patchDef["match"].(map[string]interface{})
+		path := fmt.Sprintf("spec/configPatches[%d]/match", i)
+
+		if expected, found := matchTypes[applyTo]; found {
+			mismatched := false
+			for _, matchType := range []string{"listener", "routeConfiguration", "cluster"} {
+				if _, found := match[matchType]; found && matchType != expected {
+					mismatched = true
+				}
+			}
+			if mismatched {
+				validation := models.Build("envoyfilters.applyto.mismatchedmatch", path)
+				validations = append(validations, &validation)
+				continue
+			}
+		}
+
+		operation := ""
+		if patch, ok := patchDef["patch"].(map[string]interface{}); ok {
+			operation, _ = patch["operation"].(string)
+		}
+		if referenceOperations[operation] && !hasFilterReference(applyTo, match) {
+			validation := models.Build("envoyfilters.applyto.missingmatch", path)
+			validations = append(validations, &validation)
+		}
+	}
+
+	return validations, true
+}
+
+// hasFilterReference returns false when a network or http filter patch doesn't name the filter it operates on
+func hasFilterReference(applyTo string, match map[string]interface{}) bool {
+	if applyTo != "NETWORK_FILTER" && applyTo != "HTTP_FILTER" {
+		return true
+	}
+
+	listener, _ := match["listener"].(map[string]interface{})
+	filterChain, _ := listener["filterChain"].(map[string]interface{})
+	filter, _ := filterChain["filter"].(map[string]interface{})
+	if applyTo == "HTTP_FILTER" {
+		subFilter, _ := filter["subFilter"].(map[string]interface{})
+		name, _ := subFilter["name"].(string)
+		return name != ""
+	}
+	name, _ := filter["name"].(string)
+	return name != ""
+}
@@ -0,0 +1,123 @@
+package envoyfilters
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestHTTPFilterPatch(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := ApplyToMatchChecker{EnvoyFilter: envoyFilter("HTTP_FILTER", "INSERT_BEFORE", map[string]interface{}{
+		"context": "SIDECAR_INBOUND",
+		"listener": map[string]interface{}{
+			"filterChain": map[string]interface{}{
+				"filter": map[string]interface{}{
+					"name":      "envoy.filters.network.http_connection_manager",
+					"subFilter": map[string]interface{}{"name": "envoy.filters.http.router"},
+				},
+			},
+		},
+	})}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestHTTPFilterPatchMissingMatch(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := ApplyToMatchChecker{EnvoyFilter: envoyFilter("HTTP_FILTER", "REPLACE", map[string]interface{}{
+		"context": "SIDECAR_INBOUND",
+	})}.Check()
+
+	assert.True(valid)
+	assert.Len(vals, 1)
+	assert.Equal(models.WarningSeverity, vals[0].Severity)
+	assert.Equal("spec/configPatches[0]/match", vals[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("envoyfilters.applyto.missingmatch", vals[0]))
+}
+
+func TestHTTPFilterPatchAddWithoutReference(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := ApplyToMatchChecker{EnvoyFilter: envoyFilter("HTTP_FILTER", "INSERT_FIRST", map[string]interface{}{
+		"context": "SIDECAR_INBOUND",
+	})}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestHTTPFilterPatchInsertWithoutReference(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	for _, operation := range []string{"INSERT_BEFORE", "INSERT_AFTER"} {
+		vals, valid := ApplyToMatchChecker{EnvoyFilter: envoyFilter("HTTP_FILTER", operation, map[string]interface{}{
+			"context": "SIDECAR_INBOUND",
+		})}.Check()
+
+		assert.True(valid)
+		assert.Empty(vals, operation)
+	}
+}
+
+func TestClusterPatch(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := ApplyToMatchChecker{EnvoyFilter: envoyFilter("CLUSTER", "MERGE", map[string]interface{}{
+		"context": "SIDECAR_OUTBOUND",
+		"cluster": map[string]interface{}{"service": "reviews.bookinfo.svc.cluster.local"},
+	})}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestClusterPatchListenerMatch(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := ApplyToMatchChecker{EnvoyFilter: envoyFilter("CLUSTER", "MERGE", map[string]interface{}{
+		"context":  "SIDECAR_OUTBOUND",
+		"listener": map[string]interface{}{"portNumber": 9080},
+	})}.Check()
+
+	assert.True(valid)
+	assert.Len(vals, 1)
+	assert.Equal("spec/configPatches[0]/match", vals[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("envoyfilters.applyto.mismatchedmatch", vals[0]))
+}
+
+func envoyFilter(applyTo, operation string, match map[string]interface{}) kubernetes.IstioObject {
+	return (&kubernetes.GenericIstioObject{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name:      "custom-filter",
+			Namespace: "bookinfo",
+		},
+		Spec: map[string]interface{}{
+			"configPatches": []interface{}{
+				map[string]interface{}{
+					"applyTo": applyTo,
+					"match":   match,
+					"patch": map[string]interface{}{
+						"operation": operation,
+						"value":     map[string]interface{}{"name": "custom"},
+					},
+				},
+			},
+		},
+	}).DeepCopyIstioObject()
+}
@@ -1,6 +1,9 @@
 package checkers
 
 import (
+	core_v1 "k8s.io/api/core/v1"
+
+	"github.com/kiali/kiali/business/checkers/common"
 	"github.com/kiali/kiali/business/checkers/gateways"
 	"github.com/kiali/kiali/kubernetes"
 	"github.com/kiali/kiali/models"
@@ -9,9 +12,12 @@ import (
 const GatewayCheckerType = "gateway"
 
 type GatewayChecker struct {
-	GatewaysPerNamespace  [][]kubernetes.IstioObject
-	Namespace             string
-	WorkloadsPerNamespace map[string]models.WorkloadList
+	GatewaysPerNamespace        [][]kubernetes.IstioObject
+	VirtualServicesPerNamespace [][]kubernetes.IstioObject
+	Namespace                   string
+	Namespaces                  models.Namespaces
+	WorkloadsPerNamespace       map[string]models.WorkloadList
+	SecretsPerNamespace         map[string][]core_v1.Secret
 }
 
 // Check runs checks for the all namespaces actions as well as for the single namespace validations
@@ -21,11 +27,16 @@ func (g GatewayChecker) Check() models.IstioValidations {
 		GatewaysPerNamespace: g.GatewaysPerNamespace,
 	}.Check()
 
+	virtualServices := make([]kubernetes.IstioObject, 0)
+	for _, nsVirtualServices := range g.VirtualServicesPerNamespace {
+		virtualServices = append(virtualServices, nsVirtualServices...)
+	}
+
 	// Single namespace
 	for _, nssGw := range g.GatewaysPerNamespace {
 		for _, gw := range nssGw {
 			if gw.GetObjectMeta().Namespace == g.Namespace {
-				validations.MergeValidations(g.runSingleChecks(gw))
+				validations.MergeValidations(g.runSingleChecks(gw, virtualServices))
 			}
 		}
 	}
@@ -33,7 +44,7 @@ func (g GatewayChecker) Check() models.IstioValidations {
 	return validations
 }
 
-func (g GatewayChecker) runSingleChecks(gw kubernetes.IstioObject) models.IstioValidations {
+func (g GatewayChecker) runSingleChecks(gw kubernetes.IstioObject, virtualServices []kubernetes.IstioObject) models.IstioValidations {
 	key, validations := EmptyValidValidation(gw.GetObjectMeta().Name, gw.GetObjectMeta().Namespace, GatewayCheckerType)
 
 	enabledCheckers := []Checker{
@@ -41,6 +52,13 @@ func (g GatewayChecker) runSingleChecks(gw kubernetes.IstioObject) models.IstioV
 			Gateway:               gw,
 			WorkloadsPerNamespace: g.WorkloadsPerNamespace,
 		},
+		gateways.PortNumberChecker{Gateway: gw},
+		gateways.PortNameMatchChecker{Gateway: gw},
+		gateways.ServerTLSChecker{Gateway: gw},
+		gateways.CredentialNameChecker{Gateway: gw, WorkloadsPerNamespace: g.WorkloadsPerNamespace, SecretsPerNamespace: g.SecretsPerNamespace},
+		gateways.HostNamespaceChecker{Gateway: gw, Namespaces: g.Namespaces},
+		gateways.CoveredGatewayChecker{Gateway: gw, VirtualServices: virtualServices},
+		common.NamingConventionChecker{IstioObject: gw, ObjectType: kubernetes.Gateways},
 	}
 
 	for _, checker := range enabledCheckers {
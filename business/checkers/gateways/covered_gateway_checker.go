@@ -0,0 +1,98 @@
+package gateways
+
+import (
+	"fmt"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+type CoveredGatewayChecker struct {
+	Gateway         kubernetes.IstioObject
+	VirtualServices []kubernetes.IstioObject
+}
+
+// Check returns a warning for each server host not admitting the hosts of any VirtualService bound to the Gateway,
+// as no traffic flows through such a host. VirtualServices can reference the Gateway either
+// as <namespace>/<gateway name> or as a bare <gateway name> from the Gateway namespace.
+func (c CoveredGatewayChecker) Check() ([]*models.IstioCheck, bool) {
+	validations := make([]*models.IstioCheck, 0)
+
+	boundVirtualServices := c.boundVirtualServices()
+	gwNamespace := c.Gateway.GetObjectMeta().Namespace
+
+	if servers, ok := c.Gateway.GetSpec()["servers"].([]interface{}); ok {
+		for serverIndex, server := range servers {
+			serverDef, ok := server.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			// Servers redirecting to https don't route any traffic by themselves
+			if tls, found := models.ParseGatewayServerTLS(server); found && tls.HTTPSRedirect {
+				continue
+			}
+			hosts, ok := serverDef["hosts"].([]interface{})
+			if !ok {
+				continue
+			}
+			for hostIndex, host := range hosts {
+				serverHost, ok := host.(string)
+				if !ok {
+					continue
+				}
+				if !isHostCovered(serverHost, gwNamespace, boundVirtualServices) {
+					validation := models.Build("gateways.hostnotcovered",
+						fmt.Sprintf("spec/servers[%d]/hosts[%d]", serverIndex, hostIndex))
+					validations = append(validations, &validation)
+				}
+			}
+		}
+	}
+
+	return validations, true
+}
+
+// boundVirtualServices returns the VirtualServices whose spec.gateways reference the Gateway
+func (c CoveredGatewayChecker) boundVirtualServices() []kubernetes.IstioObject {
+	bound := make([]kubernetes.IstioObject, 0)
+	gwMeta := c.Gateway.GetObjectMeta()
+
+	for _, vs := range c.VirtualServices {
+		vsMeta := vs.GetObjectMeta()
+		clusterName := vsMeta.ClusterName
+		if clusterName == "" {
+			clusterName = config.Get().ExternalServices.Istio.IstioIdentityDomain
+		}
+		if gateways, ok := vs.GetSpec()["gateways"].([]interface{}); ok {
+			for _, gw := range gateways {
+				if gwName, ok := gw.(string); ok {
+					gwHost := kubernetes.ParseGatewayAsHost(gwName, vsMeta.Namespace, clusterName)
+					if gwHost.Service == gwMeta.Name && gwHost.Namespace == gwMeta.Namespace {
+						bound = append(bound, vs)
+						break
+					}
+				}
+			}
+		}
+	}
+
+	return bound
+}
+
+func isHostCovered(serverHost, gwNamespace string, virtualServices []kubernetes.IstioObject) bool {
+	for _, vs := range virtualServices {
+		vsHosts := make([]string, 0)
+		if hosts, ok := vs.GetSpec()["hosts"].([]interface{}); ok {
+			for _, host := range hosts {
+				if hostName, ok := host.(string); ok {
+					vsHosts = append(vsHosts, hostName)
+				}
+			}
+		}
+		if kubernetes.GatewayServerHostAdmits(serverHost, gwNamespace, vs.GetObjectMeta().Namespace, vsHosts) {
+			return true
+		}
+	}
+	return false
+}
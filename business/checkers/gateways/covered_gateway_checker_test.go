@@ -0,0 +1,75 @@
+package gateways
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestGatewayHostCovered(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := CoveredGatewayChecker{
+		Gateway: coveredGateway("bookinfo-gateway", "bookinfo", "reviews.example.com"),
+		VirtualServices: []kubernetes.IstioObject{
+			data.AddGatewaysToVirtualService([]string{"bookinfo-gateway"},
+				data.CreateEmptyVirtualService("reviews", "bookinfo", []string{"reviews.example.com"})),
+		},
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestGatewayHostNotCovered(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := CoveredGatewayChecker{
+		Gateway: coveredGateway("bookinfo-gateway", "bookinfo", "reviews.example.com", "ratings.example.com"),
+		VirtualServices: []kubernetes.IstioObject{
+			data.AddGatewaysToVirtualService([]string{"bookinfo/bookinfo-gateway"},
+				data.CreateEmptyVirtualService("reviews", "bookinfo", []string{"reviews.example.com"})),
+			// Not bound to the gateway
+			data.CreateEmptyVirtualService("ratings", "bookinfo", []string{"ratings.example.com"}),
+		},
+	}.Check()
+
+	assert.True(valid)
+	assert.Len(vals, 1)
+	assert.Equal(models.WarningSeverity, vals[0].Severity)
+	assert.Equal("spec/servers[0]/hosts[1]", vals[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("gateways.hostnotcovered", vals[0]))
+}
+
+func TestGatewayHostCoveredCrossNamespace(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	gateway := coveredGateway("ingress", "istio-system", "bookinfo/*.example.com", "*/ratings.example.com")
+	virtualServices := []kubernetes.IstioObject{
+		data.AddGatewaysToVirtualService([]string{"istio-system/ingress"},
+			data.CreateEmptyVirtualService("reviews", "bookinfo", []string{"reviews.example.com"})),
+		// A bare gateway name references a gateway of the VirtualService namespace
+		data.AddGatewaysToVirtualService([]string{"ingress"},
+			data.CreateEmptyVirtualService("ratings", "bookinfo", []string{"ratings.example.com"})),
+	}
+
+	vals, valid := CoveredGatewayChecker{Gateway: gateway, VirtualServices: virtualServices}.Check()
+
+	assert.True(valid)
+	assert.Len(vals, 1)
+	assert.Equal("spec/servers[0]/hosts[1]", vals[0].Path)
+}
+
+func coveredGateway(name, namespace string, hosts ...string) kubernetes.IstioObject {
+	return data.AddServerToGateway(data.CreateServer(hosts, 80, "http", "http"),
+		data.CreateEmptyGateway(name, namespace, map[string]string{"istio": "ingressgateway"}))
+}
@@ -0,0 +1,107 @@
+package gateways
+
+import (
+	"fmt"
+	"sort"
+
+	core_v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+type CredentialNameChecker struct {
+	Gateway               kubernetes.IstioObject
+	WorkloadsPerNamespace map[string]models.WorkloadList
+	// SecretsPerNamespace holds the secrets of the namespaces of the gateway workloads.
+	// A namespace is missing when its secrets couldn't be read.
+	SecretsPerNamespace map[string][]core_v1.Secret
+}
+
+// Check returns an error for each SIMPLE or MUTUAL server referencing a credentialName without a matching secret
+// in the namespace of a gateway workload, as the listener of that workload never comes up.
+// Servers using file mounted certificates and namespaces whose secrets couldn't be read are skipped.
+func (c CredentialNameChecker) Check() ([]*models.IstioCheck, bool) {
+	validations := make([]*models.IstioCheck, 0)
+
+	if servers, ok := c.Gateway.GetSpec()["servers"].([]interface{}); ok {
+		namespaces := GatewayWorkloadNamespaces(c.Gateway, c.WorkloadsPerNamespace)
+		for serverIndex, server := range servers {
+			credentialName, found := serverCredentialName(server)
+			if !found {
+				continue
+			}
+			for _, namespace := range namespaces {
+				secrets, known := c.SecretsPerNamespace[namespace]
+				if !known || hasSecret(secrets, credentialName) {
+					continue
+				}
+				validation := models.Build("gateways.tls.credentialnotfound", fmt.Sprintf("spec/servers[%d]/tls/credentialName", serverIndex))
+				validation.Remediation = fmt.Sprintf("Create the secret %s in namespace %s, where the gateway workload runs", credentialName, namespace)
+				validations = append(validations, &validation)
+				break
+			}
+		}
+	}
+
+	return validations, len(validations) == 0
+}
+
+// CredentialSecretNamespaces returns the namespaces whose secrets are needed to validate the credentialName
+// of the Gateway servers, or nil when no server references a credential secret
+func CredentialSecretNamespaces(gw kubernetes.IstioObject, workloadsPerNamespace map[string]models.WorkloadList) []string {
+	if servers, ok := gw.GetSpec()["servers"].([]interface{}); ok {
+		for _, server := range servers {
+			if _, found := serverCredentialName(server); found {
+				return GatewayWorkloadNamespaces(gw, workloadsPerNamespace)
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayWorkloadNamespaces returns the sorted namespaces of the workloads selected by the Gateway
+func GatewayWorkloadNamespaces(gw kubernetes.IstioObject, workloadsPerNamespace map[string]models.WorkloadList) []string {
+	selectorSpec, ok := gw.GetSpec()["selector"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	labelSelectors := make(map[string]string, len(selectorSpec))
+	for k, v := range selectorSpec {
+		if s, ok := v.(string); ok {
+			labelSelectors[k] = s
+		}
+	}
+	selector := labels.SelectorFromSet(labelSelectors)
+
+	namespaces := make([]string, 0)
+	for namespace, wls := range workloadsPerNamespace {
+		for _, wl := range wls.Workloads {
+			if selector.Matches(labels.Set(wl.Labels)) {
+				namespaces = append(namespaces, namespace)
+				break
+			}
+		}
+	}
+	sort.Strings(namespaces)
+	return namespaces
+}
+
+// serverCredentialName returns the credentialName of a SIMPLE or MUTUAL server
+func serverCredentialName(server interface{}) (string, bool) {
+	tls, found := models.ParseGatewayServerTLS(server)
+	if !found || tls.CredentialName == "" || (tls.Mode != "SIMPLE" && tls.Mode != "MUTUAL") {
+		return "", false
+	}
+	return tls.CredentialName, true
+}
+
+func hasSecret(secrets []core_v1.Secret, name string) bool {
+	for _, secret := range secrets {
+		if secret.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,112 @@
+package gateways
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	core_v1 "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestCredentialNamePresent(t *testing.T) {
+	config.Set(config.NewConfig())
+	assert := assert.New(t)
+
+	vals, valid := CredentialNameChecker{
+		Gateway:               tlsGateway(map[string]interface{}{"mode": "SIMPLE", "credentialName": "bookinfo-credential"}),
+		WorkloadsPerNamespace: ingressWorkloads(),
+		SecretsPerNamespace:   map[string][]core_v1.Secret{"istio-system": {credentialSecret("bookinfo-credential")}},
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestCredentialNameNotFound(t *testing.T) {
+	config.Set(config.NewConfig())
+	assert := assert.New(t)
+
+	vals, valid := CredentialNameChecker{
+		Gateway:               tlsGateway(map[string]interface{}{"mode": "MUTUAL", "credentialName": "bookinfo-credential"}),
+		WorkloadsPerNamespace: ingressWorkloads(),
+		SecretsPerNamespace:   map[string][]core_v1.Secret{"istio-system": {credentialSecret("reviews-credential")}},
+	}.Check()
+
+	assert.False(valid)
+	assert.Len(vals, 1)
+	assert.Equal(models.ErrorSeverity, vals[0].Severity)
+	assert.Equal("spec/servers[0]/tls/credentialName", vals[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("gateways.tls.credentialnotfound", vals[0]))
+	assert.Equal("Create the secret bookinfo-credential in namespace istio-system, where the gateway workload runs", vals[0].Remediation)
+}
+
+func TestCredentialNameInAnotherNamespace(t *testing.T) {
+	config.Set(config.NewConfig())
+	assert := assert.New(t)
+
+	secret := credentialSecret("bookinfo-credential")
+	secret.Namespace = "test"
+
+	vals, valid := CredentialNameChecker{
+		Gateway:               tlsGateway(map[string]interface{}{"mode": "SIMPLE", "credentialName": "bookinfo-credential"}),
+		WorkloadsPerNamespace: ingressWorkloads(),
+		SecretsPerNamespace:   map[string][]core_v1.Secret{"istio-system": {}, "test": {secret}},
+	}.Check()
+
+	assert.False(valid)
+	assert.Len(vals, 1)
+}
+
+func TestCredentialNameUnreadableSecrets(t *testing.T) {
+	config.Set(config.NewConfig())
+	assert := assert.New(t)
+
+	vals, valid := CredentialNameChecker{
+		Gateway:               tlsGateway(map[string]interface{}{"mode": "SIMPLE", "credentialName": "bookinfo-credential"}),
+		WorkloadsPerNamespace: ingressWorkloads(),
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestCredentialNameFileMount(t *testing.T) {
+	config.Set(config.NewConfig())
+	assert := assert.New(t)
+
+	vals, valid := CredentialNameChecker{
+		Gateway: tlsGateway(map[string]interface{}{
+			"mode":              "SIMPLE",
+			"serverCertificate": "/etc/certs/servercert.pem",
+			"privateKey":        "/etc/certs/privatekey.pem",
+		}),
+		WorkloadsPerNamespace: ingressWorkloads(),
+		SecretsPerNamespace:   map[string][]core_v1.Secret{"istio-system": {}},
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func ingressWorkloads() map[string]models.WorkloadList {
+	return map[string]models.WorkloadList{
+		"istio-system": data.CreateWorkloadList("istio-system",
+			data.CreateWorkloadListItem("istio-ingressgateway", map[string]string{"istio": "ingressgateway"})),
+		"test": data.CreateWorkloadList("test",
+			data.CreateWorkloadListItem("reviews", map[string]string{"app": "reviews"})),
+	}
+}
+
+func credentialSecret(name string) core_v1.Secret {
+	return core_v1.Secret{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name:      name,
+			Namespace: "istio-system",
+		},
+	}
+}
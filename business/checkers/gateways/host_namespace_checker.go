@@ -0,0 +1,47 @@
+package gateways
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+type HostNamespaceChecker struct {
+	Gateway    kubernetes.IstioObject
+	Namespaces models.Namespaces
+}
+
+// Check returns a warning for each server host in the <namespace>/<host> form whose namespace
+// isn't found among the accessible namespaces, as such a host won't admit any VirtualService.
+func (h HostNamespaceChecker) Check() ([]*models.IstioCheck, bool) {
+	validations := make([]*models.IstioCheck, 0)
+
+	if servers, ok := h.Gateway.GetSpec()["servers"].([]interface{}); ok {
+		for serverIndex, server := range servers {
+			serverDef, ok := server.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			hosts, ok := serverDef["hosts"].([]interface{})
+			if !ok {
+				continue
+			}
+			for hostIndex, host := range hosts {
+				hostName, ok := host.(string)
+				if !ok || !strings.Contains(hostName, "/") {
+					continue
+				}
+				namespace := strings.SplitN(hostName, "/", 2)[0]
+				if namespace != "." && namespace != "*" && namespace != "~" && !h.Namespaces.Includes(namespace) {
+					validation := models.Build("gateways.hosts.namespacenotfound",
+						fmt.Sprintf("spec/servers[%d]/hosts[%d]", serverIndex, hostIndex))
+					validations = append(validations, &validation)
+				}
+			}
+		}
+	}
+
+	return validations, true
+}
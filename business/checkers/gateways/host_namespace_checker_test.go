@@ -0,0 +1,52 @@
+package gateways
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestHostNamespaceAccessible(t *testing.T) {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	assert := assert.New(t)
+
+	gw := data.AddServerToGateway(data.CreateServer([]string{"bookinfo/reviews.bookinfo.svc.cluster.local", "./productpage", "*/ratings", "details"}, 80, "http", "HTTP"),
+		data.CreateEmptyGateway("bookinfo-gateway", "bookinfo", map[string]string{"istio": "ingressgateway"}))
+
+	vals, valid := HostNamespaceChecker{
+		Gateway:    gw,
+		Namespaces: models.Namespaces{{Name: "bookinfo"}, {Name: "istio-system"}},
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestHostNamespaceNotAccessible(t *testing.T) {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	assert := assert.New(t)
+
+	gw := data.AddServerToGateway(data.CreateServer([]string{"bookinfo/reviews.bookinfo.svc.cluster.local"}, 80, "http", "HTTP"),
+		data.CreateEmptyGateway("bookinfo-gateway", "bookinfo", map[string]string{"istio": "ingressgateway"}))
+	gw = data.AddServerToGateway(data.CreateServer([]string{"*/productpage", "hidden/ratings.hidden.svc.cluster.local"}, 443, "https", "HTTPS"), gw)
+
+	vals, valid := HostNamespaceChecker{
+		Gateway:    gw,
+		Namespaces: models.Namespaces{{Name: "bookinfo"}, {Name: "istio-system"}},
+	}.Check()
+
+	assert.True(valid)
+	assert.Len(vals, 1)
+	assert.Equal(models.WarningSeverity, vals[0].Severity)
+	assert.Equal("spec/servers[1]/hosts[1]", vals[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("gateways.hosts.namespacenotfound", vals[0]))
+}
@@ -0,0 +1,38 @@
+package gateways
+
+import (
+	"fmt"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+type PortNameMatchChecker struct {
+	Gateway kubernetes.IstioObject
+}
+
+// Check returns an error for each server reusing the port name of a previous server of the Gateway.
+// Names are compared case-sensitively, as Envoy does.
+func (p PortNameMatchChecker) Check() ([]*models.IstioCheck, bool) {
+	validations := make([]*models.IstioCheck, 0)
+
+	if servers, ok := p.Gateway.GetSpec()["servers"].([]interface{}); ok {
+		names := make(map[string]bool, len(servers))
+		for serverIndex, server := range servers {
+			if serverDef, ok := server.(map[string]interface{}); ok {
+				if portDef, ok := serverDef["port"].(map[string]interface{}); ok {
+					if name, ok := portDef["name"].(string); ok && name != "" {
+						if names[name] {
+							validation := models.Build("gateways.port.duplicatename",
+								fmt.Sprintf("spec/servers[%d]/port/name", serverIndex))
+							validations = append(validations, &validation)
+						}
+						names[name] = true
+					}
+				}
+			}
+		}
+	}
+
+	return validations, len(validations) == 0
+}
@@ -0,0 +1,45 @@
+package gateways
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestGatewayUniquePortNames(t *testing.T) {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	assert := assert.New(t)
+
+	gw := data.AddServerToGateway(data.CreateServer([]string{"*.local"}, 80, "http", "HTTP"),
+		data.CreateEmptyGateway("istio-ingressgateway", "test", map[string]string{"istio": "ingressgateway"}))
+	gw = data.AddServerToGateway(data.CreateServer([]string{"*.local"}, 443, "HTTP", "HTTPS"), gw)
+
+	vals, valid := PortNameMatchChecker{Gateway: gw}.Check()
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestGatewayDuplicatePortNames(t *testing.T) {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	assert := assert.New(t)
+
+	gw := data.AddServerToGateway(data.CreateServer([]string{"*.local"}, 80, "http", "HTTP"),
+		data.CreateEmptyGateway("istio-ingressgateway", "test", map[string]string{"istio": "ingressgateway"}))
+	gw = data.AddServerToGateway(data.CreateServer([]string{"*.bookinfo"}, 8080, "http", "HTTP"), gw)
+
+	vals, valid := PortNameMatchChecker{Gateway: gw}.Check()
+	assert.False(valid)
+	assert.Len(vals, 1)
+	assert.Equal(models.ErrorSeverity, vals[0].Severity)
+	assert.NoError(validations.ConfirmIstioCheckMessage("gateways.port.duplicatename", vals[0]))
+	assert.Equal("spec/servers[1]/port/name", vals[0].Path)
+}
@@ -0,0 +1,46 @@
+package gateways
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestGatewayValidPortNumber(t *testing.T) {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	assert := assert.New(t)
+
+	gw := data.AddServerToGateway(data.CreateServer([]string{"*.local"}, 8443, "tls", "TLS"),
+		data.CreateEmptyGateway("istio-egressgateway", "test", map[string]string{"istio": "egressgateway"}))
+
+	vals, valid := PortNumberChecker{Gateway: gw}.Check()
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestGatewayInvalidPortNumbers(t *testing.T) {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	assert := assert.New(t)
+
+	gw := data.AddServerToGateway(data.CreateServer([]string{"*.local"}, 0, "tls", "TLS"),
+		data.CreateEmptyGateway("istio-egressgateway", "test", map[string]string{"istio": "egressgateway"}))
+	gw = data.AddServerToGateway(data.CreateServer([]string{"*.local"}, 8443, "tls", "TLS"), gw)
+	gw = data.AddServerToGateway(data.CreateServer([]string{"*.local"}, 70000, "tls", "TLS"), gw)
+
+	vals, valid := PortNumberChecker{Gateway: gw}.Check()
+	assert.False(valid)
+	assert.Len(vals, 2)
+	assert.Equal(models.ErrorSeverity, vals[0].Severity)
+	assert.NoError(validations.ConfirmIstioCheckMessage("port.number.outofrange", vals[0]))
+	assert.Equal("spec/servers[0]/port/number", vals[0].Path)
+	assert.Equal("spec/servers[2]/port/number", vals[1].Path)
+}
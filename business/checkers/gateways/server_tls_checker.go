@@ -0,0 +1,41 @@
+package gateways
+
+import (
+	"fmt"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+type ServerTLSChecker struct {
+	Gateway kubernetes.IstioObject
+}
+
+// Check parses the tls settings of each Gateway server and runs the tls validations on them
+func (s ServerTLSChecker) Check() ([]*models.IstioCheck, bool) {
+	validations := make([]*models.IstioCheck, 0)
+
+	if servers, ok := s.Gateway.GetSpec()["servers"].([]interface{}); ok {
+		for serverIndex, server := range servers {
+			if tls, found := models.ParseGatewayServerTLS(server); found {
+				validations = append(validations, validateServerTLS(serverIndex, tls)...)
+			}
+		}
+	}
+
+	return validations, len(validations) == 0
+}
+
+func validateServerTLS(serverIndex int, tls *models.GatewayServerTLS) []*models.IstioCheck {
+	validations := make([]*models.IstioCheck, 0)
+
+	// SIMPLE and MUTUAL modes terminate TLS, so the gateway needs a certificate to present
+	if tls.Mode == "SIMPLE" || tls.Mode == "MUTUAL" {
+		if tls.CredentialName == "" && (tls.ServerCertificate == "" || tls.PrivateKey == "") {
+			validation := models.Build("gateways.tls.certificatemissing", fmt.Sprintf("spec/servers[%d]/tls", serverIndex))
+			validations = append(validations, &validation)
+		}
+	}
+
+	return validations
+}
@@ -0,0 +1,77 @@
+package gateways
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestGatewayServerTLSWithCertificates(t *testing.T) {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	assert := assert.New(t)
+
+	gw := tlsGateway(map[string]interface{}{"mode": "SIMPLE", "credentialName": "bookinfo-credential"})
+	gw = data.AddServerToGateway(tlsServer(map[string]interface{}{
+		"mode":              "MUTUAL",
+		"serverCertificate": "/etc/certs/servercert.pem",
+		"privateKey":        "/etc/certs/privatekey.pem",
+		"caCertificates":    "/etc/certs/caroot.pem",
+	}), gw)
+	gw = data.AddServerToGateway(tlsServer(map[string]interface{}{"mode": "PASSTHROUGH"}), gw)
+
+	vals, valid := ServerTLSChecker{Gateway: gw}.Check()
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestGatewayServerTLSWithoutCertificates(t *testing.T) {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	assert := assert.New(t)
+
+	gw := tlsGateway(map[string]interface{}{"mode": "SIMPLE"})
+	gw = data.AddServerToGateway(tlsServer(map[string]interface{}{"mode": "MUTUAL", "serverCertificate": "/etc/certs/servercert.pem"}), gw)
+
+	vals, valid := ServerTLSChecker{Gateway: gw}.Check()
+	assert.False(valid)
+	assert.Len(vals, 2)
+	assert.Equal(models.ErrorSeverity, vals[0].Severity)
+	assert.NoError(validations.ConfirmIstioCheckMessage("gateways.tls.certificatemissing", vals[0]))
+	assert.Equal("spec/servers[0]/tls", vals[0].Path)
+	assert.Equal("spec/servers[1]/tls", vals[1].Path)
+}
+
+func TestGatewayServerTLSFileMountWithoutServerCertificate(t *testing.T) {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	assert := assert.New(t)
+
+	gw := tlsGateway(map[string]interface{}{"mode": "SIMPLE", "privateKey": "/etc/certs/privatekey.pem"})
+
+	vals, valid := ServerTLSChecker{Gateway: gw}.Check()
+	assert.False(valid)
+	assert.Len(vals, 1)
+	assert.NoError(validations.ConfirmIstioCheckMessage("gateways.tls.certificatemissing", vals[0]))
+	assert.Equal("spec/servers[0]/tls", vals[0].Path)
+}
+
+func tlsGateway(tls map[string]interface{}) kubernetes.IstioObject {
+	return data.AddServerToGateway(tlsServer(tls),
+		data.CreateEmptyGateway("istio-ingressgateway", "test", map[string]string{"istio": "ingressgateway"}))
+}
+
+func tlsServer(tls map[string]interface{}) map[string]interface{} {
+	server := data.CreateServer([]string{"*.local"}, 443, "https", "HTTPS")
+	server["tls"] = tls
+	return server
+}
@@ -20,6 +20,8 @@ type NoServiceChecker struct {
 	GatewaysPerNamespace [][]kubernetes.IstioObject
 	AuthorizationDetails *kubernetes.RBACDetails
 	RegistryStatus       []*kubernetes.RegistryStatus
+	// ClusterRegistryStatus holds the registry of each cluster, keyed by cluster name
+	ClusterRegistryStatus map[string][]*kubernetes.RegistryStatus
 }
 
 func (in NoServiceChecker) Check() models.IstioValidations {
@@ -32,13 +34,17 @@ func (in NoServiceChecker) Check() models.IstioValidations {
 	serviceNames := getServiceNames(in.Services)
 	serviceHosts := kubernetes.ServiceEntryHostnames(in.IstioDetails.ServiceEntries)
 	gatewayNames := kubernetes.GatewayNames(in.GatewaysPerNamespace)
+	gateways := make([]kubernetes.IstioObject, 0)
+	for _, nsGateways := range in.GatewaysPerNamespace {
+		gateways = append(gateways, nsGateways...)
+	}
 
 	for _, virtualService := range in.IstioDetails.VirtualServices {
 		validations.MergeValidations(runVirtualServiceCheck(virtualService, in.Namespace, serviceNames, serviceHosts, in.Namespaces, in.RegistryStatus))
-		validations.MergeValidations(runGatewayCheck(virtualService, gatewayNames))
+		validations.MergeValidations(runGatewayCheck(virtualService, gatewayNames, gateways))
 	}
 	for _, destinationRule := range in.IstioDetails.DestinationRules {
-		validations.MergeValidations(runDestinationRuleCheck(destinationRule, in.Namespace, in.WorkloadList, in.Services, serviceHosts, in.Namespaces, in.RegistryStatus))
+		validations.MergeValidations(runDestinationRuleCheck(destinationRule, in.Namespace, in.WorkloadList, in.Services, in.IstioDetails.ServiceEntries, in.Namespaces, in.RegistryStatus, in.ClusterRegistryStatus))
 	}
 	return validations
 }
@@ -61,7 +67,7 @@ func runVirtualServiceCheck(virtualService kubernetes.IstioObject, namespace str
 	return models.IstioValidations{key: validations}
 }
 
-func runGatewayCheck(virtualService kubernetes.IstioObject, gatewayNames map[string]struct{}) models.IstioValidations {
+func runGatewayCheck(virtualService kubernetes.IstioObject, gatewayNames map[string]struct{}, gateways []kubernetes.IstioObject) models.IstioValidations {
 	key, validations := EmptyValidValidation(virtualService.GetObjectMeta().Name, virtualService.GetObjectMeta().Namespace, VirtualCheckerType)
 
 	result, valid := virtualservices.NoGatewayChecker{
@@ -69,24 +75,36 @@ func runGatewayCheck(virtualService kubernetes.IstioObject, gatewayNames map[str
 		GatewayNames:   gatewayNames,
 	}.Check()
 
-	validations.Valid = valid
-	validations.Checks = result
+	hostMatchResult, _ := virtualservices.GatewayHostMatchChecker{
+		VirtualService: virtualService,
+		Gateways:       gateways,
+	}.Check()
+
+	tlsResult, tlsValid := virtualservices.GatewayTLSModeChecker{
+		VirtualService: virtualService,
+		Gateways:       gateways,
+	}.Check()
+
+	validations.Valid = valid && tlsValid
+	validations.Checks = append(result, hostMatchResult...)
+	validations.Checks = append(validations.Checks, tlsResult...)
 
 	return models.IstioValidations{key: validations}
 }
 
 func runDestinationRuleCheck(destinationRule kubernetes.IstioObject, namespace string, workloads models.WorkloadList,
-	services []core_v1.Service, serviceHosts map[string][]string, clusterNamespaces models.Namespaces, registryStatus []*kubernetes.RegistryStatus) models.IstioValidations {
+	services []core_v1.Service, serviceEntries []kubernetes.IstioObject, clusterNamespaces models.Namespaces, registryStatus []*kubernetes.RegistryStatus, clusterRegistryStatus map[string][]*kubernetes.RegistryStatus) models.IstioValidations {
 	key, validations := EmptyValidValidation(destinationRule.GetObjectMeta().Name, destinationRule.GetObjectMeta().Namespace, DestinationRuleCheckerType)
 
 	result, valid := destinationrules.NoDestinationChecker{
-		Namespace:       namespace,
-		Namespaces:      clusterNamespaces,
-		WorkloadList:    workloads,
-		DestinationRule: destinationRule,
-		Services:        services,
-		ServiceEntries:  serviceHosts,
-		RegistryStatus:  registryStatus,
+		Namespace:             namespace,
+		Namespaces:            clusterNamespaces,
+		WorkloadList:          workloads,
+		DestinationRule:       destinationRule,
+		Services:              services,
+		ServiceEntries:        serviceEntries,
+		RegistryStatus:        registryStatus,
+		ClusterRegistryStatus: clusterRegistryStatus,
 	}.Check()
 
 	validations.Valid = valid
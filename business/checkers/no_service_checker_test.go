@@ -171,6 +171,31 @@ func TestObjectWithoutGateway(t *testing.T) {
 	assert.NoError(validations.ConfirmIstioCheckMessage("virtualservices.nogateway", productVs.Checks[0]))
 }
 
+func TestGatewayHostNotMatchedOnce(t *testing.T) {
+	conf := config.NewConfig()
+	config.Set(conf)
+	assert := assert.New(t)
+
+	gateway := data.AddServerToGateway(data.CreateServer([]string{"reviews.example.com"}, 80, "http", "http"),
+		data.CreateEmptyGateway("my-gateway", "test", map[string]string{"istio": "ingressgateway"}))
+
+	istioDetails := fakeIstioDetails()
+	istioDetails.VirtualServices[0].GetSpec()["gateways"] = []interface{}{"my-gateway"}
+	vals := NoServiceChecker{
+		Namespace:            "test",
+		IstioDetails:         istioDetails,
+		Services:             fakeServiceDetails([]string{"reviews", "product", "customer"}),
+		GatewaysPerNamespace: [][]kubernetes.IstioObject{{gateway}},
+		AuthorizationDetails: &kubernetes.RBACDetails{},
+	}.Check()
+
+	// The product host is not admitted by my-gateway, which is reported once, per host
+	productVs := vals[models.IstioValidationKey{ObjectType: "virtualservice", Namespace: "test", Name: "product-vs"}]
+	assert.Len(productVs.Checks, 1)
+	assert.Equal("spec/hosts[0]", productVs.Checks[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("virtualservices.gateway.hostnotmatched", productVs.Checks[0]))
+}
+
 func fakeIstioDetails() *kubernetes.IstioDetails {
 	istioDetails := kubernetes.IstioDetails{}
 
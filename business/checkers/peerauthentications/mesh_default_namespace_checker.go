@@ -0,0 +1,30 @@
+package peerauthentications
+
+import (
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+type MeshDefaultNamespaceChecker struct {
+	PeerAuthn kubernetes.IstioObject
+}
+
+// Check returns a warning when a PeerAuthentication without selector lives outside the Istio root namespace.
+// Such a policy only applies to its own namespace, although it is often meant to be the mesh-wide default.
+func (m MeshDefaultNamespaceChecker) Check() ([]*models.IstioCheck, bool) {
+	validations := make([]*models.IstioCheck, 0)
+
+	if m.PeerAuthn.GetObjectMeta().Namespace == config.Get().IstioNamespace {
+		return validations, true
+	}
+
+	if selector, found := m.PeerAuthn.GetSpec()["selector"]; found && selector != nil {
+		return validations, true
+	}
+
+	validation := models.Build("peerauthentications.meshdefault.notrootnamespace", "spec")
+	validations = append(validations, &validation)
+
+	return validations, true
+}
@@ -0,0 +1,51 @@
+package peerauthentications
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestMeshDefaultInRootNamespace(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := MeshDefaultNamespaceChecker{
+		PeerAuthn: data.CreateEmptyMeshPeerAuthentication("default", data.CreateMTLS("STRICT")),
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestMeshDefaultInOtherNamespace(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := MeshDefaultNamespaceChecker{
+		PeerAuthn: data.CreateEmptyPeerAuthentication("default", "bookinfo", data.CreateMTLS("STRICT")),
+	}.Check()
+
+	assert.True(valid)
+	assert.Len(vals, 1)
+	assert.Equal(models.WarningSeverity, vals[0].Severity)
+	assert.Equal("spec", vals[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("peerauthentications.meshdefault.notrootnamespace", vals[0]))
+}
+
+func TestSelectorInOtherNamespace(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := MeshDefaultNamespaceChecker{
+		PeerAuthn: data.CreateEmptyPeerAuthenticationWithSelector("reviews", "bookinfo", data.CreateOneLabelSelector("reviews")),
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
@@ -0,0 +1,97 @@
+package peerauthentications
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	core_v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+type PortLevelMtlsChecker struct {
+	PeerAuthn    kubernetes.IstioObject
+	Services     []core_v1.Service
+	WorkloadList models.WorkloadList
+}
+
+// Check returns a warning for each portLevelMtls port not exposed by the services of the selected workloads.
+// PeerAuthentications without selector, or whose ports can't be resolved, are not checked.
+func (p PortLevelMtlsChecker) Check() ([]*models.IstioCheck, bool) {
+	validations := make([]*models.IstioCheck, 0)
+
+	portLevelMtls, ok := p.PeerAuthn.GetSpec()["portLevelMtls"].(map[string]interface{})
+	if !ok || len(portLevelMtls) == 0 {
+		return validations, true
+	}
+
+	ports, resolved := p.selectedPorts()
+	if !resolved {
+		return validations, true
+	}
+
+	portKeys := make([]string, 0, len(portLevelMtls))
+	for port := range portLevelMtls {
+		portKeys = append(portKeys, port)
+	}
+	sort.Strings(portKeys)
+
+	for _, port := range portKeys {
+		portNumber, err := strconv.Atoi(port)
+		if err != nil || !ports[int32(portNumber)] {
+			validation := models.Build("peerauthentications.mtls.portnotfound", fmt.Sprintf("spec/portLevelMtls/%s", port))
+			validations = append(validations, &validation)
+		}
+	}
+
+	return validations, true
+}
+
+// selectedPorts returns the ports and target ports of the services selecting the workloads matched by the selector.
+// It returns false when there is no selector, no service matches or some target port is named.
+func (p PortLevelMtlsChecker) selectedPorts() (map[int32]bool, bool) {
+	selector, ok := p.PeerAuthn.GetSpec()["selector"].(map[string]interface{})
+	if !ok {
+		return nil, false
+	}
+	matchLabels, ok := selector["matchLabels"].(map[string]interface{})
+	if !ok || len(matchLabels) == 0 {
+		return nil, false
+	}
+
+	paSelector := labels.Set{}
+	for k, v := range matchLabels {
+		if value, ok := v.(string); ok {
+			paSelector[k] = value
+		}
+	}
+	paLabelSelector := labels.SelectorFromSet(paSelector)
+
+	ports := make(map[int32]bool)
+	for _, wl := range p.WorkloadList.Workloads {
+		if !paLabelSelector.Matches(labels.Set(wl.Labels)) {
+			continue
+		}
+		for _, svc := range p.Services {
+			if svc.Namespace != p.PeerAuthn.GetObjectMeta().Namespace || len(svc.Spec.Selector) == 0 ||
+				!labels.SelectorFromSet(labels.Set(svc.Spec.Selector)).Matches(labels.Set(wl.Labels)) {
+				continue
+			}
+			for _, port := range svc.Spec.Ports {
+				if port.TargetPort.Type == intstr.String {
+					return nil, false
+				}
+				ports[port.Port] = true
+				if port.TargetPort.IntVal > 0 {
+					ports[port.TargetPort.IntVal] = true
+				}
+			}
+		}
+	}
+
+	return ports, len(ports) > 0
+}
@@ -0,0 +1,95 @@
+package peerauthentications
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	core_v1 "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestPortLevelMtlsMatchingPort(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := PortLevelMtlsChecker{
+		PeerAuthn:    portLevelPeerAuthn(data.CreateOneLabelSelector("reviews"), "9080"),
+		Services:     []core_v1.Service{portLevelService()},
+		WorkloadList: portLevelWorkloads(),
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestPortLevelMtlsNonMatchingPort(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := PortLevelMtlsChecker{
+		PeerAuthn:    portLevelPeerAuthn(data.CreateOneLabelSelector("reviews"), "9080", "8080"),
+		Services:     []core_v1.Service{portLevelService()},
+		WorkloadList: portLevelWorkloads(),
+	}.Check()
+
+	assert.True(valid)
+	assert.Len(vals, 1)
+	assert.Equal(models.WarningSeverity, vals[0].Severity)
+	assert.Equal("spec/portLevelMtls/8080", vals[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("peerauthentications.mtls.portnotfound", vals[0]))
+}
+
+func TestPortLevelMtlsWithoutSelector(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	pa := data.CreateEmptyMeshPeerAuthentication("default", data.CreateMTLS("STRICT"))
+	pa.GetSpec()["portLevelMtls"] = map[string]interface{}{"8080": data.CreateMTLS("DISABLE")}
+
+	vals, valid := PortLevelMtlsChecker{
+		PeerAuthn:    pa,
+		Services:     []core_v1.Service{portLevelService()},
+		WorkloadList: portLevelWorkloads(),
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func portLevelPeerAuthn(selector map[string]interface{}, ports ...string) kubernetes.IstioObject {
+	pa := data.CreateEmptyPeerAuthenticationWithSelector("reviews", "bookinfo", selector)
+	portLevelMtls := make(map[string]interface{}, len(ports))
+	for _, port := range ports {
+		portLevelMtls[port] = data.CreateMTLS("DISABLE")
+	}
+	pa.GetSpec()["portLevelMtls"] = portLevelMtls
+	return pa
+}
+
+func portLevelService() core_v1.Service {
+	return core_v1.Service{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name:      "reviews",
+			Namespace: "bookinfo",
+		},
+		Spec: core_v1.ServiceSpec{
+			Selector: map[string]string{"app": "reviews"},
+			Ports: []core_v1.ServicePort{
+				{Name: "http", Port: 80, TargetPort: intstr.FromInt(9080)},
+			},
+		},
+	}
+}
+
+func portLevelWorkloads() models.WorkloadList {
+	return data.CreateWorkloadList("bookinfo",
+		data.CreateWorkloadListItem("reviews-v1", map[string]string{"app": "reviews", "version": "v1"}),
+	)
+}
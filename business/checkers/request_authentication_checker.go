@@ -2,6 +2,7 @@ package checkers
 
 import (
 	"github.com/kiali/kiali/business/checkers/common"
+	"github.com/kiali/kiali/business/checkers/requestauthentications"
 	"github.com/kiali/kiali/kubernetes"
 	"github.com/kiali/kiali/models"
 )
@@ -32,6 +33,7 @@ func (m RequestAuthenticationChecker) runChecks(requestAuthn kubernetes.IstioObj
 
 	enabledCheckers := []Checker{
 		common.SelectorNoWorkloadFoundChecker(RequestAuthenticationCheckerType, requestAuthn, m.WorkloadList),
+		requestauthentications.JwksChecker{RequestAuthentication: requestAuthn},
 	}
 
 	for _, checker := range enabledCheckers {
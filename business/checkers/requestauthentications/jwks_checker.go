@@ -0,0 +1,49 @@
+package requestauthentications
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+type JwksChecker struct {
+	RequestAuthentication kubernetes.IstioObject
+}
+
+// Check returns an error for each jwtRule with neither an inline jwks, a jwksUri nor an issuer,
+// and for each jwtRule whose inline jwks is not valid JSON. Istio fetches the keys of a rule
+// with only an issuer through OpenID discovery.
+func (j JwksChecker) Check() ([]*models.IstioCheck, bool) {
+	validations := make([]*models.IstioCheck, 0)
+
+	jwtRules, ok := j.RequestAuthentication.GetSpec()["jwtRules"].([]interface{})
+	if !ok {
+		return validations, true
+	}
+
+	for ruleIdx, jwtRule := range jwtRules {
+		rule, ok := jwtRule.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		path := fmt.Sprintf("spec/jwtRules[%d]", ruleIdx)
+		jwks, hasJwks := rule["jwks"].(string)
+		jwksURI, hasJwksURI := rule["jwksUri"].(string)
+		issuer, hasIssuer := rule["issuer"].(string)
+
+		if hasJwks && jwks != "" {
+			if !json.Valid([]byte(jwks)) {
+				validation := models.Build("requestauthentications.jwt.invalidjwks", path)
+				validations = append(validations, &validation)
+			}
+		} else if (!hasJwksURI || jwksURI == "") && (!hasIssuer || issuer == "") {
+			validation := models.Build("requestauthentications.jwt.nojwksnouri", path)
+			validations = append(validations, &validation)
+		}
+	}
+
+	return validations, len(validations) == 0
+}
@@ -0,0 +1,101 @@
+package requestauthentications
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestInlineValidJwks(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := JwksChecker{RequestAuthentication: jwtRequestAuthentication(map[string]interface{}{
+		"issuer": "testing@secure.istio.io",
+		"jwks":   `{"keys":[{"e":"AQAB","kid":"DHFbpoIUqrY8t2zpA2qXfCmr5VO5ZEr4RzHU_-envvQ","kty":"RSA","n":"xAE7eB6q"}]}`,
+	})}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestInlineMalformedJwks(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := JwksChecker{RequestAuthentication: jwtRequestAuthentication(map[string]interface{}{
+		"issuer": "testing@secure.istio.io",
+		"jwks":   `{"keys":[{"e":"AQAB"`,
+	})}.Check()
+
+	assert.False(valid)
+	assert.Len(vals, 1)
+	assert.Equal(models.ErrorSeverity, vals[0].Severity)
+	assert.Equal("spec/jwtRules[0]", vals[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("requestauthentications.jwt.invalidjwks", vals[0]))
+}
+
+func TestJwksUriOnly(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := JwksChecker{RequestAuthentication: jwtRequestAuthentication(map[string]interface{}{
+		"issuer":  "testing@secure.istio.io",
+		"jwksUri": "https://raw.githubusercontent.com/istio/istio/release-1.9/security/tools/jwt/samples/jwks.json",
+	})}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestIssuerOnly(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	// The keys are discovered from the issuer
+	vals, valid := JwksChecker{RequestAuthentication: jwtRequestAuthentication(map[string]interface{}{
+		"issuer": "https://accounts.google.com",
+	})}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestNoJwksNoUri(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := JwksChecker{RequestAuthentication: jwtRequestAuthentication(
+		map[string]interface{}{
+			"issuer":  "testing@secure.istio.io",
+			"jwksUri": "https://example.com/jwks.json",
+		},
+		map[string]interface{}{
+			"audiences": []interface{}{"bookinfo"},
+		},
+	)}.Check()
+
+	assert.False(valid)
+	assert.Len(vals, 1)
+	assert.Equal(models.ErrorSeverity, vals[0].Severity)
+	assert.Equal("spec/jwtRules[1]", vals[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("requestauthentications.jwt.nojwksnouri", vals[0]))
+}
+
+func jwtRequestAuthentication(jwtRules ...interface{}) kubernetes.IstioObject {
+	return (&kubernetes.GenericIstioObject{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name:      "jwt-example",
+			Namespace: "bookinfo",
+		},
+		Spec: map[string]interface{}{
+			"jwtRules": jwtRules,
+		},
+	}).DeepCopyIstioObject()
+}
@@ -6,15 +6,18 @@ import (
 	v1 "k8s.io/api/core/v1"
 
 	"github.com/kiali/kiali/business/checkers/services"
+	"github.com/kiali/kiali/kubernetes"
 	"github.com/kiali/kiali/models"
 )
 
 const ServiceCheckerType = "service"
 
 type ServiceChecker struct {
-	Services    []v1.Service
-	Deployments []apps_v1.Deployment
-	Pods        []core_v1.Pod
+	Services         []v1.Service
+	Deployments      []apps_v1.Deployment
+	Pods             []core_v1.Pod
+	DestinationRules []kubernetes.IstioObject
+	WorkloadList     models.WorkloadList
 }
 
 func (sc ServiceChecker) Check() models.IstioValidations {
@@ -32,6 +35,7 @@ func (sc ServiceChecker) runSingleChecks(service v1.Service) models.IstioValidat
 
 	enabledCheckers := []Checker{
 		services.PortMappingChecker{Service: service, Deployments: sc.Deployments, Pods: sc.Pods},
+		services.MissingDestinationRuleChecker{Service: service, DestinationRules: sc.DestinationRules, WorkloadList: sc.WorkloadList},
 	}
 
 	for _, checker := range enabledCheckers {
@@ -1,7 +1,10 @@
 package checkers
 
 import (
+	core_v1 "k8s.io/api/core/v1"
+
 	"github.com/kiali/kiali/business/checkers/common"
+	"github.com/kiali/kiali/business/checkers/serviceentries"
 	"github.com/kiali/kiali/kubernetes"
 	"github.com/kiali/kiali/models"
 )
@@ -9,8 +12,11 @@ import (
 const ServiceEntryCheckerType = "serviceentry"
 
 type ServiceEntryChecker struct {
-	ServiceEntries []kubernetes.IstioObject
-	Namespaces     models.Namespaces
+	ServiceEntries   []kubernetes.IstioObject
+	DestinationRules []kubernetes.IstioObject
+	Namespaces       models.Namespaces
+	Services         []core_v1.Service
+	RegistryStatus   []*kubernetes.RegistryStatus
 }
 
 func (s ServiceEntryChecker) Check() models.IstioValidations {
@@ -28,6 +34,13 @@ func (s ServiceEntryChecker) runSingleChecks(se kubernetes.IstioObject) models.I
 
 	enabledCheckers := []Checker{
 		common.ExportToNamespaceChecker{IstioObject: se, Namespaces: s.Namespaces},
+		common.NamingConventionChecker{IstioObject: se, ObjectType: kubernetes.ServiceEntries},
+		serviceentries.PortNumberChecker{ServiceEntry: se},
+		serviceentries.DuplicatePortNameChecker{ServiceEntry: se},
+		serviceentries.ResolutionChecker{ServiceEntry: se},
+		serviceentries.IPHostChecker{ServiceEntry: se},
+		serviceentries.HostAlreadyDefinedChecker{ServiceEntry: se, Services: s.Services, RegistryStatus: s.RegistryStatus},
+		serviceentries.PassthroughLoadBalancingChecker{ServiceEntry: se, DestinationRules: s.DestinationRules},
 	}
 
 	for _, checker := range enabledCheckers {
@@ -0,0 +1,35 @@
+package serviceentries
+
+import (
+	"fmt"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+type DuplicatePortNameChecker struct {
+	ServiceEntry kubernetes.IstioObject
+}
+
+// Check returns an error for each port reusing the name of a previous port of the ServiceEntry
+func (d DuplicatePortNameChecker) Check() ([]*models.IstioCheck, bool) {
+	validations := make([]*models.IstioCheck, 0)
+
+	if ports, ok := d.ServiceEntry.GetSpec()["ports"].([]interface{}); ok {
+		names := make(map[string]bool, len(ports))
+		for portIndex, port := range ports {
+			if portDef, ok := port.(map[string]interface{}); ok {
+				if name, ok := portDef["name"].(string); ok {
+					if names[name] {
+						validation := models.Build("port.name.duplicate",
+							fmt.Sprintf("spec/ports[%d]/name", portIndex))
+						validations = append(validations, &validation)
+					}
+					names[name] = true
+				}
+			}
+		}
+	}
+
+	return validations, len(validations) == 0
+}
@@ -0,0 +1,46 @@
+package serviceentries
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestUniquePortNames(t *testing.T) {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	assert := assert.New(t)
+
+	se := data.AddPortDefinitionToServiceEntry(data.CreateEmptyPortDefinition(443, "https", "HTTPS"),
+		data.AddPortDefinitionToServiceEntry(data.CreateEmptyPortDefinition(80, "http", "HTTP"),
+			data.CreateEmptyMeshExternalServiceEntry("wikipedia", "test", []string{"wikipedia.org"})))
+
+	vals, valid := DuplicatePortNameChecker{ServiceEntry: se}.Check()
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestDuplicatePortNames(t *testing.T) {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	assert := assert.New(t)
+
+	se := data.AddPortDefinitionToServiceEntry(data.CreateEmptyPortDefinition(8080, "http", "HTTP"),
+		data.AddPortDefinitionToServiceEntry(data.CreateEmptyPortDefinition(443, "https", "HTTPS"),
+			data.AddPortDefinitionToServiceEntry(data.CreateEmptyPortDefinition(80, "http", "HTTP"),
+				data.CreateEmptyMeshExternalServiceEntry("wikipedia", "test", []string{"wikipedia.org"}))))
+
+	vals, valid := DuplicatePortNameChecker{ServiceEntry: se}.Check()
+	assert.False(valid)
+	assert.Len(vals, 1)
+	assert.Equal(models.ErrorSeverity, vals[0].Severity)
+	assert.NoError(validations.ConfirmIstioCheckMessage("port.name.duplicate", vals[0]))
+	assert.Equal("spec/ports[2]/name", vals[0].Path)
+}
@@ -0,0 +1,63 @@
+package serviceentries
+
+import (
+	"fmt"
+	"strings"
+
+	core_v1 "k8s.io/api/core/v1"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+type HostAlreadyDefinedChecker struct {
+	ServiceEntry   kubernetes.IstioObject
+	Services       []core_v1.Service
+	RegistryStatus []*kubernetes.RegistryStatus
+}
+
+// Check returns a warning for each host owned by an in-mesh Kubernetes Service,
+// as the ServiceEntry shadows the service. Wildcard hosts are not checked.
+func (h HostAlreadyDefinedChecker) Check() ([]*models.IstioCheck, bool) {
+	validations := make([]*models.IstioCheck, 0)
+
+	hosts, ok := h.ServiceEntry.GetSpec()["hosts"].([]interface{})
+	if !ok {
+		return validations, true
+	}
+
+	for hostIndex, host := range hosts {
+		sHost, ok := host.(string)
+		if !ok || strings.Contains(sHost, "*") {
+			continue
+		}
+		if h.isServiceHost(sHost) || h.isRegistryServiceHost(sHost) {
+			validation := models.Build("serviceentries.host.alreadydefined", fmt.Sprintf("spec/hosts[%d]", hostIndex))
+			validations = append(validations, &validation)
+		}
+	}
+
+	return validations, true
+}
+
+func (h HostAlreadyDefinedChecker) isServiceHost(host string) bool {
+	domain := config.Get().ExternalServices.Istio.IstioIdentityDomain
+	for _, svc := range h.Services {
+		if host == fmt.Sprintf("%s.%s.%s", svc.Name, svc.Namespace, domain) {
+			return true
+		}
+	}
+	return false
+}
+
+// isRegistryServiceHost returns true when the host belongs to a service of the Kubernetes registry,
+// ignoring the registry entries built from ServiceEntries
+func (h HostAlreadyDefinedChecker) isRegistryServiceHost(host string) bool {
+	for _, rs := range h.RegistryStatus {
+		if rs.Hostname == host && rs.Attributes["ServiceRegistry"] == "Kubernetes" {
+			return true
+		}
+	}
+	return false
+}
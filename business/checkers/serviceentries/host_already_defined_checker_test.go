@@ -0,0 +1,83 @@
+package serviceentries
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	core_v1 "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestExternalHostNotDefined(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	se := data.CreateEmptyMeshExternalServiceEntry("wikipedia", "test", []string{"wikipedia.org"})
+
+	vals, valid := HostAlreadyDefinedChecker{ServiceEntry: se, Services: reviewsServices()}.Check()
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestHostAlreadyDefinedByService(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	se := data.CreateEmptyMeshExternalServiceEntry("reviews", "test", []string{"wikipedia.org", "reviews.prod.svc.cluster.local"})
+
+	vals, valid := HostAlreadyDefinedChecker{ServiceEntry: se, Services: reviewsServices()}.Check()
+	assert.True(valid)
+	assert.Len(vals, 1)
+	assert.Equal(models.WarningSeverity, vals[0].Severity)
+	assert.Equal("spec/hosts[1]", vals[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("serviceentries.host.alreadydefined", vals[0]))
+}
+
+func TestHostAlreadyDefinedInRegistry(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	se := data.CreateEmptyMeshExternalServiceEntry("ratings", "test", []string{"ratings.prod.svc.cluster.local"})
+
+	registryService := kubernetes.RegistryStatus{}
+	registryService.Hostname = "ratings.prod.svc.cluster.local"
+	registryService.Attributes = map[string]interface{}{"ServiceRegistry": "Kubernetes"}
+
+	vals, valid := HostAlreadyDefinedChecker{ServiceEntry: se, RegistryStatus: []*kubernetes.RegistryStatus{&registryService}}.Check()
+	assert.True(valid)
+	assert.Len(vals, 1)
+
+	registryService.Attributes = map[string]interface{}{"ServiceRegistry": "External"}
+
+	vals, valid = HostAlreadyDefinedChecker{ServiceEntry: se, RegistryStatus: []*kubernetes.RegistryStatus{&registryService}}.Check()
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestWildcardHostNotChecked(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	se := data.CreateEmptyMeshExternalServiceEntry("prod", "test", []string{"*.prod.svc.cluster.local"})
+
+	vals, valid := HostAlreadyDefinedChecker{ServiceEntry: se, Services: reviewsServices()}.Check()
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func reviewsServices() []core_v1.Service {
+	return []core_v1.Service{
+		{
+			ObjectMeta: meta_v1.ObjectMeta{
+				Name:      "reviews",
+				Namespace: "prod",
+			},
+		},
+	}
+}
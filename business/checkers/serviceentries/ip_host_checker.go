@@ -0,0 +1,29 @@
+package serviceentries
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+type IPHostChecker struct {
+	ServiceEntry kubernetes.IstioObject
+}
+
+// Check returns a warning for each host that is an IP address, as IPs belong to spec.addresses
+func (i IPHostChecker) Check() ([]*models.IstioCheck, bool) {
+	validations := make([]*models.IstioCheck, 0)
+
+	if hosts, ok := i.ServiceEntry.GetSpec()["hosts"].([]interface{}); ok {
+		for hostIndex, host := range hosts {
+			if sHost, ok := host.(string); ok && net.ParseIP(sHost) != nil {
+				validation := models.Build("serviceentries.hosts.ipaddress", fmt.Sprintf("spec/hosts[%d]", hostIndex))
+				validations = append(validations, &validation)
+			}
+		}
+	}
+
+	return validations, true
+}
@@ -0,0 +1,41 @@
+package serviceentries
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestDNSHost(t *testing.T) {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	assert := assert.New(t)
+
+	se := data.CreateEmptyMeshExternalServiceEntry("wikipedia", "test", []string{"wikipedia.org"})
+
+	vals, valid := IPHostChecker{ServiceEntry: se}.Check()
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestIPHost(t *testing.T) {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	assert := assert.New(t)
+
+	se := data.CreateEmptyMeshExternalServiceEntry("mongo", "test", []string{"mongo.example.com", "192.192.192.192"})
+
+	vals, valid := IPHostChecker{ServiceEntry: se}.Check()
+	assert.True(valid)
+	assert.Len(vals, 1)
+	assert.Equal(models.WarningSeverity, vals[0].Severity)
+	assert.Equal("spec/hosts[1]", vals[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("serviceentries.hosts.ipaddress", vals[0]))
+}
@@ -0,0 +1,60 @@
+package serviceentries
+
+import (
+	"strings"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+type PassthroughLoadBalancingChecker struct {
+	ServiceEntry     kubernetes.IstioObject
+	DestinationRules []kubernetes.IstioObject
+}
+
+// Check returns a warning when a NONE resolution ServiceEntry has a host governed by a DestinationRule
+// that sets a loadBalancer or an outlierDetection, as passthrough traffic has no endpoints to balance or eject.
+// Wildcard hosts are not checked.
+func (p PassthroughLoadBalancingChecker) Check() ([]*models.IstioCheck, bool) {
+	validations := make([]*models.IstioCheck, 0)
+
+	if resolution, _ := p.ServiceEntry.GetSpec()["resolution"].(string); resolution != "NONE" {
+		return validations, true
+	}
+
+	hosts, ok := p.ServiceEntry.GetSpec()["hosts"].([]interface{})
+	if !ok {
+		return validations, true
+	}
+
+	for _, host := range hosts {
+		sHost, ok := host.(string)
+		if !ok || strings.Contains(sHost, "*") {
+			continue
+		}
+		if p.hasBalancingDestinationRule(sHost) {
+			validation := models.Build("serviceentries.resolution.nonebalanced", "spec/resolution")
+			validations = append(validations, &validation)
+			break
+		}
+	}
+
+	return validations, true
+}
+
+func (p PassthroughLoadBalancingChecker) hasBalancingDestinationRule(host string) bool {
+	for _, dr := range p.DestinationRules {
+		if drHost, ok := dr.GetSpec()["host"].(string); !ok || drHost != host {
+			continue
+		}
+		if trafficPolicy, ok := dr.GetSpec()["trafficPolicy"].(map[string]interface{}); ok {
+			if _, found := trafficPolicy["loadBalancer"]; found {
+				return true
+			}
+			if _, found := trafficPolicy["outlierDetection"]; found {
+				return true
+			}
+		}
+	}
+	return false
+}
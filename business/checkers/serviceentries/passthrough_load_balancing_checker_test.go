@@ -0,0 +1,90 @@
+package serviceentries
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestPassthroughWithLoadBalancer(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	dr := data.AddTrafficPolicyToDestinationRule(map[string]interface{}{
+		"loadBalancer": map[string]interface{}{"simple": "ROUND_ROBIN"},
+	}, data.CreateEmptyDestinationRule("test", "wikipedia", "wikipedia.org"))
+
+	vals, valid := PassthroughLoadBalancingChecker{
+		ServiceEntry:     passthroughServiceEntry("NONE"),
+		DestinationRules: []kubernetes.IstioObject{dr},
+	}.Check()
+
+	assert.True(valid)
+	assert.Len(vals, 1)
+	assert.Equal(models.WarningSeverity, vals[0].Severity)
+	assert.Equal("spec/resolution", vals[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("serviceentries.resolution.nonebalanced", vals[0]))
+}
+
+func TestPassthroughWithOutlierDetection(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	dr := data.AddTrafficPolicyToDestinationRule(map[string]interface{}{
+		"outlierDetection": map[string]interface{}{"consecutive5xxErrors": 5},
+	}, data.CreateEmptyDestinationRule("test", "wikipedia", "wikipedia.org"))
+
+	vals, valid := PassthroughLoadBalancingChecker{
+		ServiceEntry:     passthroughServiceEntry("NONE"),
+		DestinationRules: []kubernetes.IstioObject{dr},
+	}.Check()
+
+	assert.True(valid)
+	assert.Len(vals, 1)
+}
+
+func TestPassthroughWithoutBalancing(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	dr := data.AddTrafficPolicyToDestinationRule(map[string]interface{}{
+		"tls": map[string]interface{}{"mode": "SIMPLE"},
+	}, data.CreateEmptyDestinationRule("test", "wikipedia", "wikipedia.org"))
+
+	vals, valid := PassthroughLoadBalancingChecker{
+		ServiceEntry:     passthroughServiceEntry("NONE"),
+		DestinationRules: []kubernetes.IstioObject{dr},
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestDNSResolutionWithLoadBalancer(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	dr := data.AddTrafficPolicyToDestinationRule(map[string]interface{}{
+		"loadBalancer": map[string]interface{}{"simple": "ROUND_ROBIN"},
+	}, data.CreateEmptyDestinationRule("test", "wikipedia", "wikipedia.org"))
+
+	vals, valid := PassthroughLoadBalancingChecker{
+		ServiceEntry:     passthroughServiceEntry("DNS"),
+		DestinationRules: []kubernetes.IstioObject{dr},
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func passthroughServiceEntry(resolution string) kubernetes.IstioObject {
+	se := data.CreateEmptyMeshExternalServiceEntry("wikipedia", "test", []string{"wikipedia.org"})
+	se.GetSpec()["resolution"] = resolution
+	return se
+}
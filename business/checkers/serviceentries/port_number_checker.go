@@ -0,0 +1,30 @@
+package serviceentries
+
+import (
+	"fmt"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+type PortNumberChecker struct {
+	ServiceEntry kubernetes.IstioObject
+}
+
+func (p PortNumberChecker) Check() ([]*models.IstioCheck, bool) {
+	validations := make([]*models.IstioCheck, 0)
+
+	if portsSpec, found := p.ServiceEntry.GetSpec()["ports"]; found {
+		if ports, ok := portsSpec.([]interface{}); ok {
+			for portIndex, port := range ports {
+				if !kubernetes.ValidatePortNumber(port) {
+					validation := models.Build("port.number.outofrange",
+						fmt.Sprintf("spec/ports[%d]/number", portIndex))
+					validations = append(validations, &validation)
+				}
+			}
+		}
+	}
+
+	return validations, len(validations) == 0
+}
@@ -0,0 +1,58 @@
+package serviceentries
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestValidPortNumber(t *testing.T) {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	assert := assert.New(t)
+
+	se := data.AddPortDefinitionToServiceEntry(
+		data.CreateEmptyPortDefinition(8443, "tcp", "TCP"),
+		data.CreateEmptyMeshExternalServiceEntry("sni-proxy", "test", []string{"sni-proxy.local"}),
+	)
+
+	vals, valid := PortNumberChecker{ServiceEntry: se}.Check()
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestZeroPortNumber(t *testing.T) {
+	testInvalidPortNumber(t, 0)
+}
+
+func TestOutOfRangePortNumber(t *testing.T) {
+	testInvalidPortNumber(t, 70000)
+}
+
+func testInvalidPortNumber(t *testing.T, number uint32) {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	assert := assert.New(t)
+
+	se := data.AddPortDefinitionToServiceEntry(
+		data.CreateEmptyPortDefinition(number, "tcp", "TCP"),
+		data.AddPortDefinitionToServiceEntry(
+			data.CreateEmptyPortDefinition(8443, "tcp-sni", "TCP"),
+			data.CreateEmptyMeshExternalServiceEntry("sni-proxy", "test", []string{"sni-proxy.local"}),
+		),
+	)
+
+	vals, valid := PortNumberChecker{ServiceEntry: se}.Check()
+	assert.False(valid)
+	assert.Len(vals, 1)
+	assert.Equal(models.ErrorSeverity, vals[0].Severity)
+	assert.NoError(validations.ConfirmIstioCheckMessage("port.number.outofrange", vals[0]))
+	assert.Equal("spec/ports[1]/number", vals[0].Path)
+}
@@ -0,0 +1,51 @@
+package serviceentries
+
+import (
+	"net"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+type ResolutionChecker struct {
+	ServiceEntry kubernetes.IstioObject
+}
+
+// Check returns a warning when the ServiceEntry resolution can't work with its endpoints:
+// STATIC resolution without endpoints (nor a workloadSelector for MESH_INTERNAL entries),
+// or DNS resolution whose endpoints are all IP addresses.
+func (r ResolutionChecker) Check() ([]*models.IstioCheck, bool) {
+	validations := make([]*models.IstioCheck, 0)
+
+	se := models.ServiceEntry{}
+	se.Parse(r.ServiceEntry)
+
+	resolution, _ := r.ServiceEntry.GetSpec()["resolution"].(string)
+	location, _ := r.ServiceEntry.GetSpec()["location"].(string)
+	endpoints := se.Endpoints()
+
+	switch resolution {
+	case "STATIC":
+		// Workload selectors only apply to MESH_INTERNAL entries
+		if len(endpoints) == 0 && !(location == "MESH_INTERNAL" && se.Spec.WorkloadSelector != nil) {
+			validation := models.Build("serviceentries.staticnoaddress", "spec/resolution")
+			validations = append(validations, &validation)
+		}
+	case "DNS":
+		if len(endpoints) > 0 && allIPEndpoints(endpoints) {
+			validation := models.Build("serviceentries.dnsnoendpoints", "spec/resolution")
+			validations = append(validations, &validation)
+		}
+	}
+
+	return validations, true
+}
+
+func allIPEndpoints(endpoints []models.ServiceEntryEndpoint) bool {
+	for _, endpoint := range endpoints {
+		if net.ParseIP(endpoint.Address) == nil {
+			return false
+		}
+	}
+	return true
+}
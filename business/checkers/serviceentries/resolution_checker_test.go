@@ -0,0 +1,81 @@
+package serviceentries
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestStaticResolutionWithoutEndpoints(t *testing.T) {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	assert := assert.New(t)
+
+	vals, valid := ResolutionChecker{ServiceEntry: resolutionServiceEntry("STATIC")}.Check()
+	assert.True(valid)
+	assert.Len(vals, 1)
+	assert.Equal(models.WarningSeverity, vals[0].Severity)
+	assert.Equal("spec/resolution", vals[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("serviceentries.staticnoaddress", vals[0]))
+}
+
+func TestDNSResolutionWithIPEndpoints(t *testing.T) {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	assert := assert.New(t)
+
+	vals, valid := ResolutionChecker{ServiceEntry: resolutionServiceEntry("DNS", "2.2.2.2", "3.3.3.3")}.Check()
+	assert.True(valid)
+	assert.Len(vals, 1)
+	assert.Equal(models.WarningSeverity, vals[0].Severity)
+	assert.Equal("spec/resolution", vals[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("serviceentries.dnsnoendpoints", vals[0]))
+}
+
+func TestDNSResolutionWithHostnameEndpoints(t *testing.T) {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	assert := assert.New(t)
+
+	vals, valid := ResolutionChecker{ServiceEntry: resolutionServiceEntry("DNS", "us.foo.bar.com", "2.2.2.2")}.Check()
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestStaticResolutionWithWorkloadSelector(t *testing.T) {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	assert := assert.New(t)
+
+	se := resolutionServiceEntry("STATIC")
+	se.GetSpec()["location"] = "MESH_INTERNAL"
+	se.GetSpec()["workloadSelector"] = map[string]interface{}{"labels": map[string]interface{}{"app": "vm"}}
+
+	vals, valid := ResolutionChecker{ServiceEntry: se}.Check()
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func resolutionServiceEntry(resolution string, addresses ...string) kubernetes.IstioObject {
+	se := data.AddPortDefinitionToServiceEntry(data.CreateEmptyPortDefinition(443, "https", "HTTPS"),
+		data.CreateEmptyMeshExternalServiceEntry("foo", "test", []string{"foo.bar.com"}))
+	se.GetSpec()["resolution"] = resolution
+	if len(addresses) > 0 {
+		endpoints := make([]interface{}, 0, len(addresses))
+		for _, address := range addresses {
+			endpoints = append(endpoints, map[string]interface{}{"address": address})
+		}
+		se.GetSpec()["endpoints"] = endpoints
+	}
+	return se
+}
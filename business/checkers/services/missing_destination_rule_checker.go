@@ -0,0 +1,66 @@
+package services
+
+import (
+	core_v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+type MissingDestinationRuleChecker struct {
+	Service          core_v1.Service
+	DestinationRules []kubernetes.IstioObject
+	WorkloadList     models.WorkloadList
+}
+
+// Check returns an informational check when the workloads selected by the Service are labeled
+// with more than one version but no DestinationRule defines subsets for the Service host,
+// as version routing can't be done without them.
+func (m MissingDestinationRuleChecker) Check() ([]*models.IstioCheck, bool) {
+	validations := make([]*models.IstioCheck, 0)
+
+	if len(m.Service.Spec.Selector) == 0 {
+		return validations, true
+	}
+
+	if !m.hasMultipleVersions() || m.hasSubsets() {
+		return validations, true
+	}
+
+	validation := models.Build("service.destinationrule.subsets.missing", "spec/selector")
+	validations = append(validations, &validation)
+
+	return validations, true
+}
+
+func (m MissingDestinationRuleChecker) hasMultipleVersions() bool {
+	selector := labels.SelectorFromSet(labels.Set(m.Service.Spec.Selector))
+
+	selected := models.WorkloadList{Namespace: m.WorkloadList.Namespace}
+	for _, wl := range m.WorkloadList.Workloads {
+		if selector.Matches(labels.Set(wl.Labels)) {
+			selected.Workloads = append(selected.Workloads, wl)
+		}
+	}
+
+	for _, versions := range selected.GroupByAppVersion() {
+		if len(versions) > 1 {
+			return true
+		}
+	}
+	return false
+}
+
+func (m MissingDestinationRuleChecker) hasSubsets() bool {
+	for _, dr := range m.DestinationRules {
+		host, ok := dr.GetSpec()["host"].(string)
+		if !ok || !kubernetes.FilterByHost(host, m.Service.Name, m.Service.Namespace) {
+			continue
+		}
+		if subsets, ok := dr.GetSpec()["subsets"].([]interface{}); ok && len(subsets) > 0 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,98 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestMultipleVersionsWithoutDestinationRule(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := MissingDestinationRuleChecker{
+		Service:          reviewsService(),
+		DestinationRules: []kubernetes.IstioObject{},
+		WorkloadList:     reviewsWorkloads("v1", "v2"),
+	}.Check()
+
+	assert.True(valid)
+	assert.Len(vals, 1)
+	assert.Equal(models.InfoSeverity, vals[0].Severity)
+	assert.Equal("spec/selector", vals[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("service.destinationrule.subsets.missing", vals[0]))
+}
+
+func TestMultipleVersionsWithDestinationRule(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := MissingDestinationRuleChecker{
+		Service: reviewsService(),
+		DestinationRules: []kubernetes.IstioObject{
+			data.CreateTestDestinationRule("bookinfo", "reviews", "reviews.bookinfo.svc.cluster.local"),
+		},
+		WorkloadList: reviewsWorkloads("v1", "v2"),
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestMultipleVersionsWithDestinationRuleWithoutSubsets(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := MissingDestinationRuleChecker{
+		Service: reviewsService(),
+		DestinationRules: []kubernetes.IstioObject{
+			data.CreateEmptyDestinationRule("bookinfo", "reviews", "reviews"),
+		},
+		WorkloadList: reviewsWorkloads("v1", "v2"),
+	}.Check()
+
+	assert.True(valid)
+	assert.Len(vals, 1)
+}
+
+func TestSingleVersionWithoutDestinationRule(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := MissingDestinationRuleChecker{
+		Service:          reviewsService(),
+		DestinationRules: []kubernetes.IstioObject{},
+		WorkloadList:     reviewsWorkloads("v1"),
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func reviewsService() v1.Service {
+	return v1.Service{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name:      "reviews",
+			Namespace: "bookinfo",
+		},
+		Spec: v1.ServiceSpec{
+			Selector: map[string]string{"app": "reviews"},
+		},
+	}
+}
+
+func reviewsWorkloads(versions ...string) models.WorkloadList {
+	items := make([]models.WorkloadListItem, 0, len(versions))
+	for _, version := range versions {
+		items = append(items, data.CreateWorkloadListItem("reviews-"+version, map[string]string{"app": "reviews", "version": version}))
+	}
+	return data.CreateWorkloadList("bookinfo", items...)
+}
@@ -2,7 +2,6 @@ package services
 
 import (
 	"fmt"
-	"strings"
 
 	apps_v1 "k8s.io/api/apps/v1"
 	core_v1 "k8s.io/api/core/v1"
@@ -24,9 +23,14 @@ func (p PortMappingChecker) Check() ([]*models.IstioCheck, bool) {
 	validations := make([]*models.IstioCheck, 0)
 
 	// Check Port naming for services in the service mesh
-	if p.hasMatchingPodsWithSidecar(p.Service) {
+	sPods := models.Pods{}
+	sPods.Parse(kubernetes.FilterPodsForService(&p.Service, p.Pods))
+	if sPods.HasIstioSidecar() {
+		workload := models.Workload{Pods: sPods}
+		protocols := workload.InboundPortProtocols(p.Service)
 		for portIndex, sp := range p.Service.Spec.Ports {
-			if strings.ToLower(string(sp.Protocol)) == "udp" {
+			// Port names don't matter when the protocol is set by appProtocol, the transport protocol or when the sidecar is bypassed
+			if source := protocols[portIndex].Source; source != models.PortProtocolFromName && source != models.PortProtocolDetected {
 				continue
 			} else if !kubernetes.MatchPortNameWithValidProtocols(sp.Name) {
 				validation := models.Build("port.name.mismatch", fmt.Sprintf("spec/ports[%d]", portIndex))
@@ -42,12 +46,6 @@ func (p PortMappingChecker) Check() ([]*models.IstioCheck, bool) {
 	return validations, len(validations) == 0
 }
 
-func (p PortMappingChecker) hasMatchingPodsWithSidecar(service v1.Service) bool {
-	sPods := models.Pods{}
-	sPods.Parse(kubernetes.FilterPodsForService(&service, p.Pods))
-	return sPods.HasIstioSidecar()
-}
-
 func (p PortMappingChecker) findMatchingDeployment(selectors map[string]string) *apps_v1.Deployment {
 	if len(selectors) == 0 {
 		return nil
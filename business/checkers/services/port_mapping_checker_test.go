@@ -122,6 +122,47 @@ func TestServicePortNamingWithoutSidecar(t *testing.T) {
 	assert.Empty(vals)
 }
 
+func TestServicePortNamingWithAppProtocol(t *testing.T) {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	assert := assert.New(t)
+
+	appProtocol := "http"
+	service := getService(9080, "http2foo")
+	service.Spec.Ports[0].AppProtocol = &appProtocol
+
+	pmc := PortMappingChecker{
+		Service:     service,
+		Deployments: getDeployment(9080),
+		Pods:        getPods(true),
+	}
+
+	vals, valid := pmc.Check()
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestServicePortNamingWithExcludedInboundPort(t *testing.T) {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	assert := assert.New(t)
+
+	pods := getPods(true)
+	pods[0].Annotations["traffic.sidecar.istio.io/excludeInboundPorts"] = "9080"
+
+	pmc := PortMappingChecker{
+		Service:     getService(9080, "http2foo"),
+		Deployments: getDeployment(9080),
+		Pods:        pods,
+	}
+
+	vals, valid := pmc.Check()
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
 func getService(servicePort int32, portName string) v1.Service {
 	return v1.Service{
 		ObjectMeta: meta_v1.ObjectMeta{
@@ -15,6 +15,7 @@ type EgressHostChecker struct {
 	Sidecar        kubernetes.IstioObject
 	ServiceEntries map[string][]string
 	Services       []core_v1.Service
+	Namespaces     models.Namespaces
 }
 
 type HostWithIndex struct {
@@ -99,6 +100,11 @@ func (elc EgressHostChecker) validateHost(host string, egrIdx, hostIdx int) ([]*
 		return checks, true
 	}
 
+	// Namespaces are only known when the list is given
+	if len(elc.Namespaces) > 0 && hostNs != "*" && hostNs != "~" && hostNs != "." && !elc.Namespaces.Includes(hostNs) {
+		return append(checks, buildCheck("sidecars.egresshost.namespacenotfound", egrIdx, hostIdx)), true
+	}
+
 	// Show cross-namespace validation
 	// when namespace is different to both istio control plane or sidecar namespace
 	if hostNs != ins && hostNs != sns && hostNs != "." {
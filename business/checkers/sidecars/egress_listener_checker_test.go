@@ -106,6 +106,45 @@ func TestEgressServiceNotFound(t *testing.T) {
 	}
 }
 
+func TestEgressHostNamespaceFound(t *testing.T) {
+	assert := assert.New(t)
+
+	vals, valid := EgressHostChecker{
+		Services:   fakeServices([]string{"details", "reviews"}),
+		Namespaces: models.Namespaces{models.Namespace{Name: "bookinfo"}, models.Namespace{Name: "istio-system"}},
+		Sidecar: sidecarWithHosts([]interface{}{
+			"bookinfo/*",
+			"bookinfo/reviews.bookinfo.svc.cluster.local",
+			"./reviews.bookinfo.svc.cluster.local",
+			"*/*.example.com",
+		}),
+	}.Check()
+
+	assert.True(valid)
+	for _, c := range vals {
+		assert.NotEqual(models.CheckMessage("sidecars.egresshost.namespacenotfound"), c.GetFullMessage())
+	}
+}
+
+func TestEgressHostNamespaceNotFound(t *testing.T) {
+	assert := assert.New(t)
+
+	vals, valid := EgressHostChecker{
+		Namespaces: models.Namespaces{models.Namespace{Name: "bookinfo"}, models.Namespace{Name: "prod"}},
+		Sidecar: sidecarWithHosts([]interface{}{
+			"bookinfo/*",
+			"prdo/*",
+			"./*",
+		}),
+	}.Check()
+
+	assert.True(valid)
+	assert.Len(vals, 1)
+	assert.Equal(models.WarningSeverity, vals[0].Severity)
+	assert.Equal("spec/egress[0]/hosts[1]", vals[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("sidecars.egresshost.namespacenotfound", vals[0]))
+}
+
 func sidecarWithHosts(hl []interface{}) kubernetes.IstioObject {
 	return data.AddHostsToSidecar(hl, data.CreateSidecar("sidecar", "bookinfo"))
 }
@@ -59,8 +59,9 @@ func (s SidecarChecker) runChecks(sidecar kubernetes.IstioObject) models.IstioVa
 
 	enabledCheckers := []Checker{
 		common.WorkloadSelectorNoWorkloadFoundChecker(SidecarCheckerType, sidecar, s.WorkloadList),
-		sidecars.EgressHostChecker{Sidecar: sidecar, Services: s.Services, ServiceEntries: serviceHosts},
+		sidecars.EgressHostChecker{Sidecar: sidecar, Services: s.Services, ServiceEntries: serviceHosts, Namespaces: s.Namespaces},
 		sidecars.GlobalChecker{Sidecar: sidecar},
+		common.NamingConventionChecker{IstioObject: sidecar, ObjectType: kubernetes.Sidecars},
 	}
 
 	for _, checker := range enabledCheckers {
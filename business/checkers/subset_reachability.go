@@ -0,0 +1,105 @@
+package checkers
+
+import (
+	"sort"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+type SubsetReachabilityResolver struct {
+	Namespace        string
+	Namespaces       models.Namespaces
+	DestinationRules []kubernetes.IstioObject
+	VirtualServices  []kubernetes.IstioObject
+}
+
+// Resolve classifies the subsets of the host, referenced from the resolver namespace, into the ones defined
+// by DestinationRules and routed to by VirtualServices (reachable), the ones only defined (unused)
+// and the ones only routed to (undefined).
+func (in SubsetReachabilityResolver) Resolve(host string) models.SubsetReachability {
+	namespaces := in.Namespaces.GetNames()
+	target := kubernetes.GetHost(host, in.Namespace, "", namespaces).String()
+
+	defined := make(map[string]bool)
+	for _, dr := range in.DestinationRules {
+		drHost, ok := dr.GetSpec()["host"].(string)
+		if !ok {
+			continue
+		}
+		meta := dr.GetObjectMeta()
+		if kubernetes.GetHost(drHost, meta.Namespace, meta.ClusterName, namespaces).String() != target {
+			continue
+		}
+		if subsets, ok := dr.GetSpec()["subsets"].([]interface{}); ok {
+			for _, subset := range subsets {
+				if subsetDef, ok := subset.(map[string]interface{}); ok {
+					if name, ok := subsetDef["name"].(string); ok {
+						defined[name] = true
+					}
+				}
+			}
+		}
+	}
+
+	routed := make(map[string]bool)
+	for _, vs := range in.VirtualServices {
+		meta := vs.GetObjectMeta()
+		for _, protocol := range []string{"http", "tcp", "tls"} {
+			routes, ok := vs.GetSpec()[protocol].([]interface{})
+			if !ok {
+				continue
+			}
+			for _, route := range routes {
+				routeDef, ok := route.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				destinationWeights, ok := routeDef["route"].([]interface{})
+				if !ok {
+					continue
+				}
+				for _, destinationWeight := range destinationWeights {
+					destinationWeightDef, ok := destinationWeight.(map[string]interface{})
+					if !ok {
+						continue
+					}
+					destination, ok := destinationWeightDef["destination"].(map[string]interface{})
+					if !ok {
+						continue
+					}
+					destHost, ok := destination["host"].(string)
+					if !ok || kubernetes.GetHost(destHost, meta.Namespace, meta.ClusterName, namespaces).String() != target {
+						continue
+					}
+					if subset, ok := destination["subset"].(string); ok && subset != "" {
+						routed[subset] = true
+					}
+				}
+			}
+		}
+	}
+
+	reachability := models.SubsetReachability{
+		Reachable: make([]string, 0),
+		Unused:    make([]string, 0),
+		Undefined: make([]string, 0),
+	}
+	for subset := range defined {
+		if routed[subset] {
+			reachability.Reachable = append(reachability.Reachable, subset)
+		} else {
+			reachability.Unused = append(reachability.Unused, subset)
+		}
+	}
+	for subset := range routed {
+		if !defined[subset] {
+			reachability.Undefined = append(reachability.Undefined, subset)
+		}
+	}
+	sort.Strings(reachability.Reachable)
+	sort.Strings(reachability.Unused)
+	sort.Strings(reachability.Undefined)
+
+	return reachability
+}
@@ -0,0 +1,55 @@
+package checkers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+)
+
+func TestSubsetReachability(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	dr := data.AddSubsetToDestinationRule(data.CreateSubset("v1", "v1"),
+		data.AddSubsetToDestinationRule(data.CreateSubset("v2", "v2"),
+			data.CreateEmptyDestinationRule("bookinfo", "reviews", "reviews.bookinfo.svc.cluster.local")))
+
+	vs := data.AddRoutesToVirtualService("http", data.CreateRoute("reviews", "v1", 50),
+		data.AddRoutesToVirtualService("http", data.CreateRoute("reviews.bookinfo", "v3", 50),
+			data.CreateEmptyVirtualService("reviews", "bookinfo", []string{"reviews"})))
+
+	// Routes to other hosts aren't taken into account
+	otherVs := data.AddRoutesToVirtualService("http", data.CreateRoute("ratings", "v2", -1),
+		data.CreateEmptyVirtualService("ratings", "bookinfo", []string{"ratings"}))
+
+	reachability := SubsetReachabilityResolver{
+		Namespace:        "bookinfo",
+		Namespaces:       models.Namespaces{models.Namespace{Name: "bookinfo"}},
+		DestinationRules: []kubernetes.IstioObject{dr},
+		VirtualServices:  []kubernetes.IstioObject{vs, otherVs},
+	}.Resolve("reviews")
+
+	assert.Equal([]string{"v1"}, reachability.Reachable)
+	assert.Equal([]string{"v2"}, reachability.Unused)
+	assert.Equal([]string{"v3"}, reachability.Undefined)
+}
+
+func TestSubsetReachabilityNoSubsets(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	reachability := SubsetReachabilityResolver{
+		Namespace:        "bookinfo",
+		Namespaces:       models.Namespaces{models.Namespace{Name: "bookinfo"}},
+		DestinationRules: []kubernetes.IstioObject{data.CreateEmptyDestinationRule("bookinfo", "reviews", "reviews")},
+	}.Resolve("reviews")
+
+	assert.Empty(reachability.Reachable)
+	assert.Empty(reachability.Unused)
+	assert.Empty(reachability.Undefined)
+}
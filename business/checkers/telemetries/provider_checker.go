@@ -0,0 +1,75 @@
+package telemetries
+
+import (
+	"fmt"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+// providerSections are the Telemetry spec sections whose entries reference extension providers
+var providerSections = []string{"accessLogging", "metrics", "tracing"}
+
+// builtInProviders are the providers Istio defines without any mesh config extensionProviders entry
+var builtInProviders = []string{"envoy", "prometheus", "stackdriver"}
+
+type ProviderChecker struct {
+	Telemetry kubernetes.IstioObject
+	// ExtensionProviders holds the names of the mesh config extensionProviders, nil when unknown
+	ExtensionProviders []string
+}
+
+// Check returns a warning for each provider referenced by the Telemetry that is neither built-in
+// nor defined in the mesh config extensionProviders
+func (p ProviderChecker) Check() ([]*models.IstioCheck, bool) {
+	validations := make([]*models.IstioCheck, 0)
+
+	// The providers can't be verified when the mesh config couldn't be read
+	if p.ExtensionProviders == nil {
+		return validations, true
+	}
+
+	for _, section := range providerSections {
+		entries, ok := p.Telemetry.GetSpec()[section].([]interface{})
+		if !ok {
+			continue
+		}
+		for i, entry := range entries {
+			entryDef, ok := entry.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			providers, ok := entryDef["providers"].([]interface{})
+			if !ok {
+				continue
+			}
+			for j, provider := range providers {
+				providerDef, ok := provider.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				name, _ := providerDef["name"].(string)
+				if !p.isDefined(name) {
+					validation := models.Build("telemetries.provider.notfound", fmt.Sprintf("spec/%s[%d]/providers[%d]/name", section, i, j))
+					validations = append(validations, &validation)
+				}
+			}
+		}
+	}
+
+	return validations, true
+}
+
+func (p ProviderChecker) isDefined(name string) bool {
+	for _, builtInProvider := range builtInProviders {
+		if builtInProvider == name {
+			return true
+		}
+	}
+	for _, extensionProvider := range p.ExtensionProviders {
+		if extensionProvider == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,112 @@
+package telemetries
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestDefinedTracingProvider(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := ProviderChecker{
+		Telemetry:          tracingTelemetry("zipkin"),
+		ExtensionProviders: []string{"zipkin", "prometheus"},
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestUndefinedTracingProvider(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := ProviderChecker{
+		Telemetry:          tracingTelemetry("zipkn"),
+		ExtensionProviders: []string{"zipkin", "prometheus"},
+	}.Check()
+
+	assert.True(valid)
+	assert.Len(vals, 1)
+	assert.Equal(models.WarningSeverity, vals[0].Severity)
+	assert.Equal("spec/tracing[0]/providers[0]/name", vals[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("telemetries.provider.notfound", vals[0]))
+}
+
+func TestNoProviders(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	telemetry := tracingTelemetry("zipkin")
+	telemetry.GetSpec()["tracing"] = []interface{}{
+		map[string]interface{}{"randomSamplingPercentage": 10},
+	}
+
+	vals, valid := ProviderChecker{
+		Telemetry:          telemetry,
+		ExtensionProviders: []string{"zipkin"},
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestBuiltInProvider(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	telemetry := tracingTelemetry("stackdriver")
+	telemetry.GetSpec()["metrics"] = []interface{}{
+		map[string]interface{}{
+			"providers": []interface{}{
+				map[string]interface{}{"name": "prometheus"},
+			},
+		},
+	}
+
+	vals, valid := ProviderChecker{
+		Telemetry:          telemetry,
+		ExtensionProviders: []string{},
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestUnknownExtensionProviders(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := ProviderChecker{
+		Telemetry: tracingTelemetry("zipkn"),
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func tracingTelemetry(provider string) kubernetes.IstioObject {
+	return (&kubernetes.GenericIstioObject{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name:      "mesh-default",
+			Namespace: "istio-system",
+		},
+		Spec: map[string]interface{}{
+			"tracing": []interface{}{
+				map[string]interface{}{
+					"providers": []interface{}{
+						map[string]interface{}{"name": provider},
+					},
+				},
+			},
+		},
+	}).DeepCopyIstioObject()
+}
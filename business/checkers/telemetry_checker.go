@@ -0,0 +1,41 @@
+package checkers
+
+import (
+	"github.com/kiali/kiali/business/checkers/telemetries"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+const TelemetryCheckerType = "telemetry"
+
+type TelemetryChecker struct {
+	Telemetries        []kubernetes.IstioObject
+	ExtensionProviders []string
+}
+
+func (t TelemetryChecker) Check() models.IstioValidations {
+	validations := models.IstioValidations{}
+
+	for _, telemetry := range t.Telemetries {
+		validations.MergeValidations(t.runChecks(telemetry))
+	}
+
+	return validations
+}
+
+// runChecks runs all the individual checks for a single telemetry and appends the result into validations.
+func (t TelemetryChecker) runChecks(telemetry kubernetes.IstioObject) models.IstioValidations {
+	key, rrValidation := EmptyValidValidation(telemetry.GetObjectMeta().Name, telemetry.GetObjectMeta().Namespace, TelemetryCheckerType)
+
+	enabledCheckers := []Checker{
+		telemetries.ProviderChecker{Telemetry: telemetry, ExtensionProviders: t.ExtensionProviders},
+	}
+
+	for _, checker := range enabledCheckers {
+		checks, validChecker := checker.Check()
+		rrValidation.Checks = append(rrValidation.Checks, checks...)
+		rrValidation.Valid = rrValidation.Valid && validChecker
+	}
+
+	return models.IstioValidations{key: rrValidation}
+}
@@ -1,6 +1,8 @@
 package checkers
 
 import (
+	core_v1 "k8s.io/api/core/v1"
+
 	"github.com/kiali/kiali/business/checkers/common"
 	"github.com/kiali/kiali/business/checkers/virtualservices"
 	"github.com/kiali/kiali/kubernetes"
@@ -14,6 +16,10 @@ type VirtualServiceChecker struct {
 	Namespaces       models.Namespaces
 	DestinationRules []kubernetes.IstioObject
 	VirtualServices  []kubernetes.IstioObject
+	Services         []core_v1.Service
+	// VirtualServicesPerNamespace are the VirtualServices of all namespaces, used to resolve delegates
+	VirtualServicesPerNamespace [][]kubernetes.IstioObject
+	WorkloadList                models.WorkloadList
 }
 
 // An Object Checker runs all checkers for an specific object type (i.e.: pod, route rule,...)
@@ -33,8 +39,13 @@ func (in VirtualServiceChecker) Check() models.IstioValidations {
 func (in VirtualServiceChecker) runIndividualChecks() models.IstioValidations {
 	validations := models.IstioValidations{}
 
+	knownVirtualServices := append([]kubernetes.IstioObject{}, in.VirtualServices...)
+	for _, nsVirtualServices := range in.VirtualServicesPerNamespace {
+		knownVirtualServices = append(knownVirtualServices, nsVirtualServices...)
+	}
+
 	for _, virtualService := range in.VirtualServices {
-		validations.MergeValidations(in.runChecks(virtualService))
+		validations.MergeValidations(in.runChecks(virtualService, knownVirtualServices))
 	}
 
 	return validations
@@ -56,14 +67,27 @@ func (in VirtualServiceChecker) runGroupChecks() models.IstioValidations {
 }
 
 // runChecks runs all the individual checks for a single virtual service and appends the result into validations.
-func (in VirtualServiceChecker) runChecks(virtualService kubernetes.IstioObject) models.IstioValidations {
+func (in VirtualServiceChecker) runChecks(virtualService kubernetes.IstioObject, knownVirtualServices []kubernetes.IstioObject) models.IstioValidations {
 	virtualServiceName := virtualService.GetObjectMeta().Name
 	key, rrValidation := EmptyValidValidation(virtualServiceName, virtualService.GetObjectMeta().Namespace, VirtualCheckerType)
 
 	enabledCheckers := []Checker{
 		virtualservices.RouteChecker{Route: virtualService},
+		virtualservices.RegexChecker{VirtualService: virtualService},
+		virtualservices.DuplicateDestinationChecker{VirtualService: virtualService},
+		virtualservices.WeightChecker{VirtualService: virtualService},
+		virtualservices.NonIdempotentRetriesChecker{VirtualService: virtualService},
+		virtualservices.DelegateChecker{VirtualService: virtualService, VirtualServices: knownVirtualServices},
 		virtualservices.SubsetPresenceChecker{Namespace: in.Namespace, Namespaces: in.Namespaces.GetNames(), DestinationRules: in.DestinationRules, VirtualService: virtualService},
+		virtualservices.MirrorSubsetPresenceChecker{Namespace: in.Namespace, Namespaces: in.Namespaces.GetNames(), DestinationRules: in.DestinationRules, VirtualService: virtualService},
 		common.ExportToNamespaceChecker{IstioObject: virtualService, Namespaces: in.Namespaces},
+		common.NamingConventionChecker{IstioObject: virtualService, ObjectType: kubernetes.VirtualServices},
+		common.NoReadyWorkloadsChecker{IstioObject: virtualService, Namespaces: in.Namespaces.GetNames(), Services: in.Services, WorkloadList: in.WorkloadList},
+		virtualservices.WildcardHostChecker{VirtualService: virtualService},
+		virtualservices.MixedWildcardHostChecker{VirtualService: virtualService},
+		virtualservices.GatewayNoRoutesChecker{VirtualService: virtualService},
+		virtualservices.IngressOnlyInternalHostChecker{Namespaces: in.Namespaces, Services: in.Services, VirtualService: virtualService},
+		virtualservices.TCPOnlyHostChecker{Namespaces: in.Namespaces, Services: in.Services, VirtualService: virtualService},
 	}
 
 	for _, checker := range enabledCheckers {
@@ -74,7 +74,7 @@ func TestVirtualServiceMixedChecker(t *testing.T) {
 	assert.True(ok)
 	assert.Equal(validation.Name, "reviews-mixed")
 	assert.Equal(validation.ObjectType, "virtualservice")
-	assert.True(validation.Valid)
+	assert.False(validation.Valid)
 	assert.Len(validation.Checks, 2)
 }
 
@@ -99,7 +99,7 @@ func TestVirtualServiceMultipleIstioObjects(t *testing.T) {
 	assert.True(ok)
 	assert.Equal(validation.Name, "reviews-mixed")
 	assert.Equal(validation.ObjectType, "virtualservice")
-	assert.True(validation.Valid)
+	assert.False(validation.Valid)
 	assert.Len(validation.Checks, 2)
 
 	validation, ok = validations[models.IstioValidationKey{ObjectType: "virtualservice", Namespace: "bookinfo", Name: "reviews-multiple"}]
@@ -0,0 +1,64 @@
+package checkers
+
+import (
+	"github.com/kiali/kiali/business/checkers/virtualservices"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+type VirtualServiceEffectivenessResolver struct {
+	Namespace            string
+	Namespaces           models.Namespaces
+	DestinationRules     []kubernetes.IstioObject
+	GatewaysPerNamespace [][]kubernetes.IstioObject
+	VirtualServices      []kubernetes.IstioObject
+}
+
+// Resolve combines the gateway binding, gateway host admission and subset presence checks of a VirtualService.
+// The VirtualService is effective unless any of them reports an error or a warning, which are returned as reasons.
+// Subsets are looked up on the effective http routes, so the routes of the delegates are checked in place of
+// the delegating routes, and the paths of those reasons point to the effective routes.
+func (in VirtualServiceEffectivenessResolver) Resolve(virtualService kubernetes.IstioObject) models.VirtualServiceEffectiveness {
+	effectiveness := models.VirtualServiceEffectiveness{Effective: true, Reasons: make([]*models.IstioCheck, 0)}
+
+	gateways := make([]kubernetes.IstioObject, 0)
+	for _, nsGateways := range in.GatewaysPerNamespace {
+		gateways = append(gateways, nsGateways...)
+	}
+
+	enabledCheckers := []Checker{
+		virtualservices.NoGatewayChecker{VirtualService: virtualService, GatewayNames: kubernetes.GatewayNames(in.GatewaysPerNamespace)},
+		virtualservices.GatewayHostChecker{VirtualService: virtualService, Gateways: gateways},
+		virtualservices.SubsetPresenceChecker{Namespace: in.Namespace, Namespaces: in.Namespaces.GetNames(), DestinationRules: in.DestinationRules, VirtualService: in.effectiveVirtualService(virtualService)},
+	}
+
+	for _, checker := range enabledCheckers {
+		checks, _ := checker.Check()
+		for _, check := range checks {
+			if check.Severity == models.ErrorSeverity || check.Severity == models.WarningSeverity {
+				effectiveness.Effective = false
+				effectiveness.Reasons = append(effectiveness.Reasons, check)
+			}
+		}
+	}
+
+	return effectiveness
+}
+
+// effectiveVirtualService returns a copy of the VirtualService whose http routes delegating to other
+// VirtualServices are replaced by the routes of the delegates
+func (in VirtualServiceEffectivenessResolver) effectiveVirtualService(virtualService kubernetes.IstioObject) kubernetes.IstioObject {
+	if _, ok := virtualService.GetSpec()["http"].([]interface{}); !ok {
+		return virtualService
+	}
+	// The copy shares the spec map, so a new one is set to keep the original VirtualService untouched
+	spec := make(map[string]interface{}, len(virtualService.GetSpec()))
+	for k, v := range virtualService.GetSpec() {
+		spec[k] = v
+	}
+	spec["http"] = kubernetes.ResolveDelegatedHTTPRoutes(virtualService, in.VirtualServices)
+
+	effective := virtualService.DeepCopyIstioObject()
+	effective.SetSpec(spec)
+	return effective
+}
@@ -0,0 +1,86 @@
+package checkers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestEffectiveVirtualService(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	virtualService := data.AddGatewaysToVirtualService([]string{"test/my-gateway", "mesh"}, data.CreateVirtualService())
+
+	effectiveness := effectivenessResolver().Resolve(virtualService)
+
+	assert.True(effectiveness.Effective)
+	assert.Empty(effectiveness.Reasons)
+}
+
+func TestVirtualServiceDeadByMissingGateway(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	virtualService := data.AddGatewaysToVirtualService([]string{"test/missing-gateway"}, data.CreateVirtualService())
+
+	effectiveness := effectivenessResolver().Resolve(virtualService)
+
+	assert.False(effectiveness.Effective)
+	assert.Len(effectiveness.Reasons, 1)
+	assert.Equal(models.ErrorSeverity, effectiveness.Reasons[0].Severity)
+	assert.Equal("spec/gateways[0]", effectiveness.Reasons[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("virtualservices.nogateway", effectiveness.Reasons[0]))
+}
+
+func effectivenessResolver() VirtualServiceEffectivenessResolver {
+	gateway := data.AddServerToGateway(data.CreateServer([]string{"reviews"}, 80, "http", "http"),
+		data.CreateEmptyGateway("my-gateway", "test", map[string]string{"istio": "ingressgateway"}))
+
+	return VirtualServiceEffectivenessResolver{
+		Namespace:  "test",
+		Namespaces: models.Namespaces{models.Namespace{Name: "test"}},
+		DestinationRules: []kubernetes.IstioObject{
+			data.CreateTestDestinationRule("test", "reviews", "reviews"),
+		},
+		GatewaysPerNamespace: [][]kubernetes.IstioObject{{gateway}},
+	}
+}
+
+func TestVirtualServiceDeadByDelegateSubset(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	root := data.CreateEmptyVirtualService("reviews-root", "test", []string{"reviews"})
+	root.GetSpec()["http"] = []interface{}{
+		map[string]interface{}{
+			"delegate": map[string]interface{}{"name": "reviews-delegate"},
+		},
+	}
+	delegate := data.CreateEmptyVirtualService("reviews-delegate", "test", []string{})
+	delegate.GetSpec()["http"] = []interface{}{
+		map[string]interface{}{
+			"route": []interface{}{
+				map[string]interface{}{"destination": map[string]interface{}{"host": "reviews", "subset": "v3"}},
+			},
+		},
+	}
+
+	resolver := effectivenessResolver()
+	resolver.VirtualServices = []kubernetes.IstioObject{root, delegate}
+	effectiveness := resolver.Resolve(root)
+
+	assert.False(effectiveness.Effective)
+	assert.Len(effectiveness.Reasons, 1)
+	assert.Equal("spec/http[0]/route[0]/destination", effectiveness.Reasons[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("virtualservices.subsetpresent.subsetnotfound", effectiveness.Reasons[0]))
+
+	// The delegating VirtualService itself is left untouched
+	assert.Contains(root.GetSpec()["http"].([]interface{})[0], "delegate")
+}
@@ -0,0 +1,46 @@
+package virtualservices
+
+import (
+	"fmt"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+type DelegateChecker struct {
+	VirtualService  kubernetes.IstioObject
+	VirtualServices []kubernetes.IstioObject
+}
+
+// Check returns an error for each http route delegating to a VirtualService that can't be found,
+// and for each http route setting both a delegate and a route, as Istio doesn't allow both.
+func (d DelegateChecker) Check() ([]*models.IstioCheck, bool) {
+	validations := make([]*models.IstioCheck, 0)
+
+	httpRoutes, ok := d.VirtualService.GetSpec()["http"].([]interface{})
+	if !ok {
+		return validations, true
+	}
+
+	for routeIdx, httpRoute := range httpRoutes {
+		route, ok := httpRoute.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if _, ok := route["delegate"].(map[string]interface{}); !ok {
+			continue
+		}
+
+		if kubernetes.FindDelegate(route, d.VirtualService.GetObjectMeta().Namespace, d.VirtualServices) == nil {
+			validation := models.Build("virtualservices.delegate.notfound", fmt.Sprintf("spec/http[%d]/delegate", routeIdx))
+			validations = append(validations, &validation)
+		}
+
+		if destinations, ok := route["route"].([]interface{}); ok && len(destinations) > 0 {
+			validation := models.Build("virtualservices.delegate.routepresent", fmt.Sprintf("spec/http[%d]/route", routeIdx))
+			validations = append(validations, &validation)
+		}
+	}
+
+	return validations, len(validations) == 0
+}
@@ -0,0 +1,69 @@
+package virtualservices
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestValidDelegate(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := DelegateChecker{
+		VirtualService:  delegatingVirtualService(map[string]interface{}{"name": "reviews-delegate", "namespace": "bookinfo"}, false),
+		VirtualServices: []kubernetes.IstioObject{data.CreateEmptyVirtualService("reviews-delegate", "bookinfo", []string{})},
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestMissingDelegate(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := DelegateChecker{
+		VirtualService:  delegatingVirtualService(map[string]interface{}{"name": "reviews-delegate"}, false),
+		VirtualServices: []kubernetes.IstioObject{data.CreateEmptyVirtualService("reviews-delegate", "bookinfo", []string{})},
+	}.Check()
+
+	assert.False(valid)
+	assert.Len(vals, 1)
+	assert.Equal(models.ErrorSeverity, vals[0].Severity)
+	assert.Equal("spec/http[0]/delegate", vals[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("virtualservices.delegate.notfound", vals[0]))
+}
+
+func TestDelegateWithRoute(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := DelegateChecker{
+		VirtualService:  delegatingVirtualService(map[string]interface{}{"name": "reviews-delegate", "namespace": "bookinfo"}, true),
+		VirtualServices: []kubernetes.IstioObject{data.CreateEmptyVirtualService("reviews-delegate", "bookinfo", []string{})},
+	}.Check()
+
+	assert.False(valid)
+	assert.Len(vals, 1)
+	assert.Equal(models.ErrorSeverity, vals[0].Severity)
+	assert.Equal("spec/http[0]/route", vals[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("virtualservices.delegate.routepresent", vals[0]))
+}
+
+func delegatingVirtualService(delegate map[string]interface{}, withRoute bool) kubernetes.IstioObject {
+	vs := data.CreateEmptyVirtualService("reviews", "test", []string{"reviews"})
+	if withRoute {
+		vs = data.AddRoutesToVirtualService("http", data.CreateRoute("reviews", "v1", -1), vs)
+	} else {
+		vs.GetSpec()["http"] = []interface{}{map[string]interface{}{}}
+	}
+	vs.GetSpec()["http"].([]interface{})[0].(map[string]interface{})["delegate"] = delegate
+	return vs
+}
@@ -0,0 +1,62 @@
+package virtualservices
+
+import (
+	"fmt"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+type DuplicateDestinationChecker struct {
+	VirtualService kubernetes.IstioObject
+}
+
+// Check returns a warning for each http route listing the same host and subset in more than one destination,
+// as splitting the traffic between identical destinations is redundant.
+func (d DuplicateDestinationChecker) Check() ([]*models.IstioCheck, bool) {
+	validations := make([]*models.IstioCheck, 0)
+
+	https, ok := d.VirtualService.GetSpec()["http"].([]interface{})
+	if !ok {
+		return validations, true
+	}
+
+	meta := d.VirtualService.GetObjectMeta()
+	for routeIdx, http := range https {
+		httpRoute, ok := http.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		destinationWeights, ok := httpRoute["route"].([]interface{})
+		if !ok || len(destinationWeights) < 2 {
+			continue
+		}
+
+		destinations := make(map[string]struct{}, len(destinationWeights))
+		for _, destinationWeight := range destinationWeights {
+			destinationWeightDef, ok := destinationWeight.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			destination, ok := destinationWeightDef["destination"].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			host, ok := destination["host"].(string)
+			if !ok {
+				continue
+			}
+			subset, _ := destination["subset"].(string)
+
+			key := kubernetes.ParseHost(host, meta.Namespace, meta.ClusterName).String() + "/" + subset
+			if _, found := destinations[key]; found {
+				validation := models.Build("virtualservices.route.duplicatedestination", fmt.Sprintf("spec/http[%d]/route", routeIdx))
+				validations = append(validations, &validation)
+				break
+			}
+			destinations[key] = struct{}{}
+		}
+	}
+
+	return validations, true
+}
@@ -0,0 +1,49 @@
+package virtualservices
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestDuplicateDestination(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vs := data.AddRoutesToVirtualService("http", data.CreateRoute("reviews", "v1", 40),
+		data.AddRoutesToVirtualService("http", data.CreateRoute("reviews.test.svc.cluster.local", "v1", 60),
+			data.CreateEmptyVirtualService("reviews", "test", []string{"reviews"}),
+		),
+	)
+
+	vals, valid := DuplicateDestinationChecker{VirtualService: vs}.Check()
+
+	assert.True(valid)
+	assert.Len(vals, 1)
+	assert.Equal(models.WarningSeverity, vals[0].Severity)
+	assert.Equal("spec/http[0]/route", vals[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("virtualservices.route.duplicatedestination", vals[0]))
+}
+
+func TestDistinctDestinations(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vs := data.AddRoutesToVirtualService("http", data.CreateRoute("reviews", "v1", 40),
+		data.AddRoutesToVirtualService("http", data.CreateRoute("reviews", "v2", 30),
+			data.AddRoutesToVirtualService("http", data.CreateRoute("ratings", "v1", 30),
+				data.CreateEmptyVirtualService("reviews", "test", []string{"reviews"}),
+			),
+		),
+	)
+
+	vals, valid := DuplicateDestinationChecker{VirtualService: vs}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
@@ -0,0 +1,101 @@
+package virtualservices
+
+import (
+	"fmt"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+type GatewayHostChecker struct {
+	VirtualService kubernetes.IstioObject
+	Gateways       []kubernetes.IstioObject
+}
+
+// Check returns a warning for each gateway bound to the VirtualService that has no server host
+// admitting any of the VirtualService hosts, as the gateway won't route any traffic through it.
+// Gateways that can't be found are left to the NoGatewayChecker.
+func (g GatewayHostChecker) Check() ([]*models.IstioCheck, bool) {
+	validations := make([]*models.IstioCheck, 0)
+
+	gateways, ok := g.VirtualService.GetSpec()["gateways"].([]interface{})
+	if !ok {
+		return validations, true
+	}
+
+	vsHosts := make([]string, 0)
+	if hosts, ok := g.VirtualService.GetSpec()["hosts"].([]interface{}); ok {
+		for _, host := range hosts {
+			if hostName, ok := host.(string); ok {
+				vsHosts = append(vsHosts, hostName)
+			}
+		}
+	}
+
+	namespace := g.VirtualService.GetObjectMeta().Namespace
+	clusterName := g.VirtualService.GetObjectMeta().ClusterName
+	if clusterName == "" {
+		clusterName = config.Get().ExternalServices.Istio.IstioIdentityDomain
+	}
+
+	for index, gw := range gateways {
+		gwName, ok := gw.(string)
+		if !ok || gwName == meshGateway {
+			continue
+		}
+
+		gwHost := kubernetes.ParseGatewayAsHost(gwName, namespace, clusterName)
+		gateway := findGateway(g.Gateways, gwHost)
+		if gateway == nil {
+			continue
+		}
+
+		if !gatewayAdmitsHosts(gateway, namespace, vsHosts) {
+			validation := models.Build("virtualservices.gateway.hostnotadmitted", fmt.Sprintf("spec/gateways[%d]", index))
+			validations = append(validations, &validation)
+		}
+	}
+
+	return validations, true
+}
+
+func findGateway(gateways []kubernetes.IstioObject, gwHost kubernetes.Host) kubernetes.IstioObject {
+	for _, gateway := range gateways {
+		if gateway.GetObjectMeta().Name == gwHost.Service && gateway.GetObjectMeta().Namespace == gwHost.Namespace {
+			return gateway
+		}
+	}
+	return nil
+}
+
+// gatewayAdmitsHosts returns true when any server host of the gateway, in the [<namespace>/]<host> form,
+// admits any of the hosts of a VirtualService living in vsNamespace.
+func gatewayAdmitsHosts(gateway kubernetes.IstioObject, vsNamespace string, vsHosts []string) bool {
+	servers, ok := gateway.GetSpec()["servers"].([]interface{})
+	if !ok {
+		return false
+	}
+
+	for _, server := range servers {
+		if serverDef, ok := server.(map[string]interface{}); ok && serverAdmitsHosts(serverDef, gateway.GetObjectMeta().Namespace, vsNamespace, vsHosts) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// serverAdmitsHosts returns true when any host of the gateway server admits any of the VirtualService hosts
+func serverAdmitsHosts(serverDef map[string]interface{}, gatewayNamespace, vsNamespace string, vsHosts []string) bool {
+	hosts, ok := serverDef["hosts"].([]interface{})
+	if !ok {
+		return false
+	}
+	for _, host := range hosts {
+		if serverHost, ok := host.(string); ok && kubernetes.GatewayServerHostAdmits(serverHost, gatewayNamespace, vsNamespace, vsHosts) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,62 @@
+package virtualservices
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestGatewayAdmitsVirtualServiceHost(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	for _, host := range []string{"reviews", "*", "test/reviews", "./reviews", "*/rev*"} {
+		vals, valid := GatewayHostChecker{
+			VirtualService: data.AddGatewaysToVirtualService([]string{"my-gateway", "mesh"}, data.CreateVirtualService()),
+			Gateways:       []kubernetes.IstioObject{gatewayWithHosts("my-gateway", "test", host)},
+		}.Check()
+
+		assert.True(valid)
+		assert.Empty(vals, host)
+	}
+}
+
+func TestGatewayDoesNotAdmitVirtualServiceHost(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	for _, host := range []string{"ratings", "*.example.com", "bookinfo/reviews", "~/reviews"} {
+		vals, valid := GatewayHostChecker{
+			VirtualService: data.AddGatewaysToVirtualService([]string{"mesh", "test/my-gateway"}, data.CreateVirtualService()),
+			Gateways:       []kubernetes.IstioObject{gatewayWithHosts("my-gateway", "test", host)},
+		}.Check()
+
+		assert.True(valid)
+		assert.Len(vals, 1, host)
+		assert.Equal("spec/gateways[1]", vals[0].Path)
+		assert.NoError(validations.ConfirmIstioCheckMessage("virtualservices.gateway.hostnotadmitted", vals[0]))
+	}
+}
+
+func TestGatewayHostMissingGateway(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := GatewayHostChecker{
+		VirtualService: data.AddGatewaysToVirtualService([]string{"my-gateway"}, data.CreateVirtualService()),
+		Gateways:       []kubernetes.IstioObject{gatewayWithHosts("other-gateway", "test", "ratings")},
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func gatewayWithHosts(name, namespace, host string) kubernetes.IstioObject {
+	return data.AddServerToGateway(data.CreateServer([]string{host}, 80, "http", "http"),
+		data.CreateEmptyGateway(name, namespace, map[string]string{"istio": "ingressgateway"}))
+}
@@ -0,0 +1,71 @@
+package virtualservices
+
+import (
+	"fmt"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+type GatewayHostMatchChecker struct {
+	VirtualService kubernetes.IstioObject
+	Gateways       []kubernetes.IstioObject
+}
+
+// Check returns a warning for each VirtualService host that no server host of the bound gateways admits,
+// as that host never receives traffic through them. VirtualServices bound only to the mesh
+// and gateways that can't be found are not checked.
+func (g GatewayHostMatchChecker) Check() ([]*models.IstioCheck, bool) {
+	validations := make([]*models.IstioCheck, 0)
+
+	gateways, ok := g.VirtualService.GetSpec()["gateways"].([]interface{})
+	if !ok {
+		return validations, true
+	}
+
+	namespace := g.VirtualService.GetObjectMeta().Namespace
+	clusterName := g.VirtualService.GetObjectMeta().ClusterName
+	if clusterName == "" {
+		clusterName = config.Get().ExternalServices.Istio.IstioIdentityDomain
+	}
+
+	boundGateways := make([]kubernetes.IstioObject, 0, len(gateways))
+	for _, gw := range gateways {
+		gwName, ok := gw.(string)
+		if !ok || gwName == meshGateway {
+			continue
+		}
+		if gateway := findGateway(g.Gateways, kubernetes.ParseGatewayAsHost(gwName, namespace, clusterName)); gateway != nil {
+			boundGateways = append(boundGateways, gateway)
+		}
+	}
+	if len(boundGateways) == 0 {
+		return validations, true
+	}
+
+	hosts, ok := g.VirtualService.GetSpec()["hosts"].([]interface{})
+	if !ok {
+		return validations, true
+	}
+
+	for hostIndex, host := range hosts {
+		hostName, ok := host.(string)
+		if !ok {
+			continue
+		}
+		matched := false
+		for _, gateway := range boundGateways {
+			if gatewayAdmitsHosts(gateway, namespace, []string{hostName}) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			validation := models.Build("virtualservices.gateway.hostnotmatched", fmt.Sprintf("spec/hosts[%d]", hostIndex))
+			validations = append(validations, &validation)
+		}
+	}
+
+	return validations, true
+}
@@ -0,0 +1,74 @@
+package virtualservices
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestGatewayHostExactMatch(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := GatewayHostMatchChecker{
+		VirtualService: gatewayHostsVirtualService([]string{"my-gateway"}, "bookinfo.example.com"),
+		Gateways:       []kubernetes.IstioObject{gatewayWithHosts("my-gateway", "test", "bookinfo.example.com")},
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestGatewayHostWildcardMatch(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := GatewayHostMatchChecker{
+		VirtualService: gatewayHostsVirtualService([]string{"my-gateway"}, "bookinfo.example.com", "reviews.example.com"),
+		Gateways:       []kubernetes.IstioObject{gatewayWithHosts("my-gateway", "test", "*.example.com")},
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestGatewayHostNotMatched(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := GatewayHostMatchChecker{
+		VirtualService: gatewayHostsVirtualService([]string{"my-gateway"}, "bookinfo.example.com", "reviews.example.org"),
+		Gateways:       []kubernetes.IstioObject{gatewayWithHosts("my-gateway", "test", "*.example.com")},
+	}.Check()
+
+	assert.True(valid)
+	assert.Len(vals, 1)
+	assert.Equal(models.WarningSeverity, vals[0].Severity)
+	assert.Equal("spec/hosts[1]", vals[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("virtualservices.gateway.hostnotmatched", vals[0]))
+}
+
+func TestGatewayHostMatchMeshOnly(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := GatewayHostMatchChecker{
+		VirtualService: gatewayHostsVirtualService([]string{"mesh"}, "reviews"),
+		Gateways:       []kubernetes.IstioObject{gatewayWithHosts("my-gateway", "test", "*.example.com")},
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func gatewayHostsVirtualService(gateways []string, hosts ...string) kubernetes.IstioObject {
+	return data.AddGatewaysToVirtualService(gateways,
+		data.AddRoutesToVirtualService("http", data.CreateRoute("reviews", "v1", -1),
+			data.CreateEmptyVirtualService("reviews", "test", hosts)))
+}
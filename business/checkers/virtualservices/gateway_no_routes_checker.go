@@ -0,0 +1,31 @@
+package virtualservices
+
+import (
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+type GatewayNoRoutesChecker struct {
+	VirtualService kubernetes.IstioObject
+}
+
+// Check returns a warning when a VirtualService is bound to gateways but its http, tcp and tls
+// sections are all missing or empty, as the gateways won't admit any traffic for its hosts.
+func (g GatewayNoRoutesChecker) Check() ([]*models.IstioCheck, bool) {
+	validations := make([]*models.IstioCheck, 0)
+
+	if gateways, ok := g.VirtualService.GetSpec()["gateways"].([]interface{}); !ok || len(gateways) == 0 {
+		return validations, true
+	}
+
+	for _, protocol := range []string{"http", "tcp", "tls"} {
+		if routes, ok := g.VirtualService.GetSpec()[protocol].([]interface{}); ok && len(routes) > 0 {
+			return validations, true
+		}
+	}
+
+	validation := models.Build("virtualservices.gateway.noroutes", "spec")
+	validations = append(validations, &validation)
+
+	return validations, true
+}
@@ -0,0 +1,51 @@
+package virtualservices
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestGatewayVirtualServiceWithRoutes(t *testing.T) {
+	assert := assert.New(t)
+
+	vs := data.AddGatewaysToVirtualService([]string{"bookinfo/bookinfo-gateway"},
+		data.AddRoutesToVirtualService("http", data.CreateRoute("productpage", "v1", -1),
+			data.CreateEmptyVirtualService("bookinfo", "bookinfo", []string{"*"})))
+
+	vals, valid := GatewayNoRoutesChecker{VirtualService: vs}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestGatewayVirtualServiceWithoutRoutes(t *testing.T) {
+	assert := assert.New(t)
+
+	vs := data.AddGatewaysToVirtualService([]string{"bookinfo/bookinfo-gateway"},
+		data.CreateEmptyVirtualService("bookinfo", "bookinfo", []string{"*"}))
+	vs.GetSpec()["http"] = []interface{}{}
+
+	vals, valid := GatewayNoRoutesChecker{VirtualService: vs}.Check()
+
+	assert.True(valid)
+	assert.Len(vals, 1)
+	assert.Equal(models.WarningSeverity, vals[0].Severity)
+	assert.Equal("spec", vals[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("virtualservices.gateway.noroutes", vals[0]))
+}
+
+func TestMeshVirtualServiceWithoutRoutes(t *testing.T) {
+	assert := assert.New(t)
+
+	vs := data.CreateEmptyVirtualService("reviews", "bookinfo", []string{"reviews"})
+
+	vals, valid := GatewayNoRoutesChecker{VirtualService: vs}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
@@ -0,0 +1,108 @@
+package virtualservices
+
+import (
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+type GatewayTLSModeChecker struct {
+	VirtualService kubernetes.IstioObject
+	Gateways       []kubernetes.IstioObject
+}
+
+// Check returns an error when the VirtualService routes don't fit the tls mode of the gateway servers admitting its hosts:
+// http routes only bound to PASSTHROUGH servers, as the gateway doesn't terminate tls, or tls routes only bound
+// to tls terminating servers, as the gateway has already terminated it. Servers without tls settings are left unchecked.
+func (g GatewayTLSModeChecker) Check() ([]*models.IstioCheck, bool) {
+	validations := make([]*models.IstioCheck, 0)
+
+	gateways, ok := g.VirtualService.GetSpec()["gateways"].([]interface{})
+	if !ok {
+		return validations, true
+	}
+
+	vsHosts := make([]string, 0)
+	if hosts, ok := g.VirtualService.GetSpec()["hosts"].([]interface{}); ok {
+		for _, host := range hosts {
+			if hostName, ok := host.(string); ok {
+				vsHosts = append(vsHosts, hostName)
+			}
+		}
+	}
+
+	_, hasHTTP := g.VirtualService.GetSpec()["http"]
+	_, hasTLS := g.VirtualService.GetSpec()["tls"]
+	_, hasTCP := g.VirtualService.GetSpec()["tcp"]
+
+	namespace := g.VirtualService.GetObjectMeta().Namespace
+	clusterName := g.VirtualService.GetObjectMeta().ClusterName
+	if clusterName == "" {
+		clusterName = config.Get().ExternalServices.Istio.IstioIdentityDomain
+	}
+
+	passthroughMismatch, terminatedMismatch := false, false
+	for _, gw := range gateways {
+		gwName, ok := gw.(string)
+		if !ok || gwName == meshGateway {
+			continue
+		}
+
+		gateway := findGateway(g.Gateways, kubernetes.ParseGatewayAsHost(gwName, namespace, clusterName))
+		if gateway == nil {
+			continue
+		}
+
+		passthrough, terminated, plain := admittingServerTLSModes(gateway, namespace, vsHosts)
+		if plain {
+			continue
+		}
+		if passthrough && !terminated && hasHTTP && !hasTLS {
+			passthroughMismatch = true
+		}
+		if terminated && !passthrough && hasTLS && !hasHTTP && !hasTCP {
+			terminatedMismatch = true
+		}
+	}
+
+	if passthroughMismatch {
+		validation := models.Build("virtualservices.gateway.passthroughhttproute", "spec/http")
+		validations = append(validations, &validation)
+	}
+	if terminatedMismatch {
+		validation := models.Build("virtualservices.gateway.terminatedtlsroute", "spec/tls")
+		validations = append(validations, &validation)
+	}
+
+	return validations, len(validations) == 0
+}
+
+// admittingServerTLSModes reports whether the gateway servers admitting any of the VirtualService hosts
+// pass tls through, terminate it, or don't set any tls mode
+func admittingServerTLSModes(gateway kubernetes.IstioObject, vsNamespace string, vsHosts []string) (passthrough, terminated, plain bool) {
+	servers, ok := gateway.GetSpec()["servers"].([]interface{})
+	if !ok {
+		return
+	}
+
+	for _, server := range servers {
+		serverDef, ok := server.(map[string]interface{})
+		if !ok || !serverAdmitsHosts(serverDef, gateway.GetObjectMeta().Namespace, vsNamespace, vsHosts) {
+			continue
+		}
+
+		mode := ""
+		if tls, ok := models.ParseGatewayServerTLS(serverDef); ok {
+			mode = tls.Mode
+		}
+		switch mode {
+		case "PASSTHROUGH", "AUTO_PASSTHROUGH":
+			passthrough = true
+		case "SIMPLE", "MUTUAL", "ISTIO_MUTUAL":
+			terminated = true
+		default:
+			plain = true
+		}
+	}
+	return
+}
@@ -0,0 +1,111 @@
+package virtualservices
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestTerminatingGatewayHTTPRoutes(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := GatewayTLSModeChecker{
+		VirtualService: httpGatewayVirtualService(),
+		Gateways:       []kubernetes.IstioObject{tlsGateway("SIMPLE")},
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestPassthroughGatewayTLSRoutes(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := GatewayTLSModeChecker{
+		VirtualService: tlsGatewayVirtualService(),
+		Gateways:       []kubernetes.IstioObject{tlsGateway("PASSTHROUGH")},
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestPassthroughGatewayHTTPRoutes(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := GatewayTLSModeChecker{
+		VirtualService: httpGatewayVirtualService(),
+		Gateways:       []kubernetes.IstioObject{tlsGateway("PASSTHROUGH")},
+	}.Check()
+
+	assert.False(valid)
+	assert.Len(vals, 1)
+	assert.Equal(models.ErrorSeverity, vals[0].Severity)
+	assert.Equal("spec/http", vals[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("virtualservices.gateway.passthroughhttproute", vals[0]))
+}
+
+func TestTerminatingGatewayTLSRoutes(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := GatewayTLSModeChecker{
+		VirtualService: tlsGatewayVirtualService(),
+		Gateways:       []kubernetes.IstioObject{tlsGateway("SIMPLE")},
+	}.Check()
+
+	assert.False(valid)
+	assert.Len(vals, 1)
+	assert.Equal(models.ErrorSeverity, vals[0].Severity)
+	assert.Equal("spec/tls", vals[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("virtualservices.gateway.terminatedtlsroute", vals[0]))
+}
+
+func TestPlainGatewayServerNotChecked(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	gateway := data.AddServerToGateway(data.CreateServer([]string{"reviews"}, 80, "http", "HTTP"), tlsGateway("PASSTHROUGH"))
+
+	vals, valid := GatewayTLSModeChecker{
+		VirtualService: httpGatewayVirtualService(),
+		Gateways:       []kubernetes.IstioObject{gateway},
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func httpGatewayVirtualService() kubernetes.IstioObject {
+	return data.AddGatewaysToVirtualService([]string{"my-gateway"},
+		data.AddRoutesToVirtualService("http", data.CreateRoute("reviews", "v1", -1),
+			data.CreateEmptyVirtualService("reviews", "test", []string{"reviews"})))
+}
+
+func tlsGatewayVirtualService() kubernetes.IstioObject {
+	vs := data.AddGatewaysToVirtualService([]string{"my-gateway"}, data.CreateEmptyVirtualService("reviews", "test", []string{"reviews"}))
+	vs.GetSpec()["tls"] = []interface{}{
+		map[string]interface{}{
+			"match": []interface{}{
+				map[string]interface{}{"sniHosts": []interface{}{"reviews"}},
+			},
+			"route": []interface{}{data.CreateRoute("reviews", "v1", -1)},
+		},
+	}
+	return vs
+}
+
+func tlsGateway(mode string) kubernetes.IstioObject {
+	server := data.CreateServer([]string{"reviews"}, 443, "https", "HTTPS")
+	server["tls"] = map[string]interface{}{"mode": mode}
+	return data.AddServerToGateway(server, data.CreateEmptyGateway("my-gateway", "test", map[string]string{"istio": "ingressgateway"}))
+}
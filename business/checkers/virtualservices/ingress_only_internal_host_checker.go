@@ -0,0 +1,60 @@
+package virtualservices
+
+import (
+	core_v1 "k8s.io/api/core/v1"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+// meshGateway is the reserved gateway name binding a VirtualService to the sidecars of the mesh
+const meshGateway = "mesh"
+
+type IngressOnlyInternalHostChecker struct {
+	Namespaces     models.Namespaces
+	Services       []core_v1.Service
+	VirtualService kubernetes.IstioObject
+}
+
+// Check returns an informational check when the VirtualService is bound to gateways but not to the mesh
+// while some of its hosts are Services of the mesh: in-mesh clients of those Services bypass its rules.
+func (i IngressOnlyInternalHostChecker) Check() ([]*models.IstioCheck, bool) {
+	validations := make([]*models.IstioCheck, 0)
+
+	gateways, ok := i.VirtualService.GetSpec()["gateways"].([]interface{})
+	if !ok || len(gateways) == 0 {
+		return validations, true
+	}
+	for _, gateway := range gateways {
+		if gateway == meshGateway {
+			return validations, true
+		}
+	}
+
+	if hosts, ok := i.VirtualService.GetSpec()["hosts"].([]interface{}); ok {
+		for _, host := range hosts {
+			if sHost, ok := host.(string); ok && i.isInternalHost(sHost) {
+				validation := models.Build("virtualservices.gateway.meshmissing", "spec/gateways")
+				validations = append(validations, &validation)
+				break
+			}
+		}
+	}
+
+	return validations, true
+}
+
+func (i IngressOnlyInternalHostChecker) isInternalHost(host string) bool {
+	meta := i.VirtualService.GetObjectMeta()
+	fqdn := kubernetes.GetHost(host, meta.Namespace, meta.ClusterName, i.Namespaces.GetNames())
+	if !fqdn.CompleteInput {
+		return false
+	}
+
+	for _, svc := range i.Services {
+		if svc.Name == fqdn.Service && svc.Namespace == fqdn.Namespace {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,65 @@
+package virtualservices
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	core_v1 "k8s.io/api/core/v1"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestIngressOnlyInternalHost(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := IngressOnlyInternalHostChecker{
+		Namespaces:     models.Namespaces{models.Namespace{Name: "bookinfo"}},
+		Services:       []core_v1.Service{fakeServiceWithPorts("reviews", "http")},
+		VirtualService: gatewayBoundVirtualService("reviews", "bookinfo-gateway"),
+	}.Check()
+
+	assert.True(valid)
+	assert.Len(vals, 1)
+	assert.Equal(models.InfoSeverity, vals[0].Severity)
+	assert.Equal("spec/gateways", vals[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("virtualservices.gateway.meshmissing", vals[0]))
+}
+
+func TestIngressAndMeshInternalHost(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := IngressOnlyInternalHostChecker{
+		Namespaces:     models.Namespaces{models.Namespace{Name: "bookinfo"}},
+		Services:       []core_v1.Service{fakeServiceWithPorts("reviews", "http")},
+		VirtualService: gatewayBoundVirtualService("reviews", "bookinfo-gateway", "mesh"),
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestIngressOnlyExternalHost(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := IngressOnlyInternalHostChecker{
+		Namespaces:     models.Namespaces{models.Namespace{Name: "bookinfo"}},
+		Services:       []core_v1.Service{fakeServiceWithPorts("reviews", "http")},
+		VirtualService: gatewayBoundVirtualService("bookinfo.example.com", "bookinfo-gateway"),
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func gatewayBoundVirtualService(host string, gateways ...string) kubernetes.IstioObject {
+	return data.AddGatewaysToVirtualService(gateways,
+		data.AddRoutesToVirtualService("http", data.CreateRoute("reviews", "v1", -1),
+			data.CreateEmptyVirtualService("reviews", "bookinfo", []string{host})))
+}
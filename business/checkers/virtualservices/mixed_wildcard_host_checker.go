@@ -0,0 +1,39 @@
+package virtualservices
+
+import (
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+type MixedWildcardHostChecker struct {
+	VirtualService kubernetes.IstioObject
+}
+
+// Check returns a warning when the '*' host is listed along with specific hosts,
+// as the wildcard already matches all of them.
+func (m MixedWildcardHostChecker) Check() ([]*models.IstioCheck, bool) {
+	validations := make([]*models.IstioCheck, 0)
+
+	hosts, ok := m.VirtualService.GetSpec()["hosts"].([]interface{})
+	if !ok {
+		return validations, true
+	}
+
+	wildcard, specific := false, false
+	for _, h := range hosts {
+		if host, ok := h.(string); ok {
+			if host == "*" {
+				wildcard = true
+			} else {
+				specific = true
+			}
+		}
+	}
+
+	if wildcard && specific {
+		validation := models.Build("virtualservices.wildcardhost.mixed", "spec/hosts")
+		validations = append(validations, &validation)
+	}
+
+	return validations, true
+}
@@ -0,0 +1,45 @@
+package virtualservices
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestWildcardOnlyHosts(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := MixedWildcardHostChecker{VirtualService: data.CreateEmptyVirtualService("reviews", "test", []string{"*"})}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestSpecificOnlyHosts(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := MixedWildcardHostChecker{VirtualService: data.CreateEmptyVirtualService("reviews", "test", []string{"reviews", "*.example.com"})}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestMixedWildcardAndSpecificHosts(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := MixedWildcardHostChecker{VirtualService: data.CreateEmptyVirtualService("reviews", "test", []string{"reviews", "*"})}.Check()
+
+	assert.True(valid)
+	assert.Len(vals, 1)
+	assert.Equal(models.WarningSeverity, vals[0].Severity)
+	assert.Equal("spec/hosts", vals[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("virtualservices.wildcardhost.mixed", vals[0]))
+}
@@ -57,6 +57,14 @@ func (n NoHostChecker) Check() ([]*models.IstioCheck, bool) {
 		}
 	}
 
+	vs := models.VirtualService{}
+	vs.Parse(n.VirtualService)
+	if vs.HasMirror() {
+		mirrorValidations, mirrorValid := n.checkMirrors()
+		validations = append(validations, mirrorValidations...)
+		valid = valid && mirrorValid
+	}
+
 	if countOfDefinedProtocols < 1 {
 		validation := models.Build("virtualservices.nohost.invalidprotocol", "")
 		validations = append(validations, &validation)
@@ -66,6 +74,57 @@ func (n NoHostChecker) Check() ([]*models.IstioCheck, bool) {
 	return validations, valid
 }
 
+// checkMirrors validates that every http mirror destination host resolves
+func (n NoHostChecker) checkMirrors() ([]*models.IstioCheck, bool) {
+	validations := make([]*models.IstioCheck, 0)
+	valid := true
+
+	httpRoutes, ok := n.VirtualService.GetSpec()["http"].([]interface{})
+	if !ok {
+		return validations, valid
+	}
+
+	for k, httpRoute := range httpRoutes {
+		mHttpRoute, ok := httpRoute.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		// Paths and hosts of the mirror destinations, in spec order
+		paths, hosts := make([]string, 0), make([]string, 0)
+		if mirror, ok := mHttpRoute["mirror"].(map[string]interface{}); ok {
+			if host, ok := mirror["host"].(string); ok {
+				paths = append(paths, fmt.Sprintf("spec/http[%d]/mirror/host", k))
+				hosts = append(hosts, host)
+			}
+		}
+		if mirrors, ok := mHttpRoute["mirrors"].([]interface{}); ok {
+			for i, mirror := range mirrors {
+				if host := parseHost(mirror); host != "" {
+					paths = append(paths, fmt.Sprintf("spec/http[%d]/mirrors[%d]/destination/host", k, i))
+					hosts = append(hosts, host)
+				}
+			}
+		}
+		for i, path := range paths {
+			host := hosts[i]
+			if n.checkDestination(host) {
+				continue
+			}
+			fqdn := kubernetes.GetHost(host, n.VirtualService.GetObjectMeta().Namespace, n.VirtualService.GetObjectMeta().ClusterName, n.Namespaces.GetNames())
+			if fqdn.Namespace != n.VirtualService.GetObjectMeta().Namespace && fqdn.CompleteInput {
+				validation := models.Build("validation.unable.cross-namespace", path)
+				validations = append(validations, &validation)
+			} else {
+				validation := models.Build("virtualservices.nohost.mirrorhostnotfound", path)
+				validations = append(validations, &validation)
+				valid = false
+			}
+		}
+	}
+
+	return validations, valid
+}
+
 func parseHost(destination interface{}) string {
 	if mDestination, ok := destination.(map[string]interface{}); ok {
 		if destinationW, ok := mDestination["destination"]; ok {
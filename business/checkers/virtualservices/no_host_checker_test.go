@@ -211,3 +211,48 @@ func TestValidServiceRegistry(t *testing.T) {
 	assert.False(valid)
 	assert.NotEmpty(vals)
 }
+
+func TestValidMirrorHost(t *testing.T) {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	assert := assert.New(t)
+
+	vals, valid := NoHostChecker{
+		Namespace:      "test",
+		ServiceNames:   []string{"reviews", "reviews-shadow"},
+		VirtualService: mirroringVirtualService("reviews-shadow"),
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestDanglingMirrorHost(t *testing.T) {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	assert := assert.New(t)
+
+	vals, valid := NoHostChecker{
+		Namespace:      "test",
+		ServiceNames:   []string{"reviews"},
+		VirtualService: mirroringVirtualService("reviews-shadow"),
+	}.Check()
+
+	assert.False(valid)
+	assert.Len(vals, 1)
+	assert.Equal(models.ErrorSeverity, vals[0].Severity)
+	assert.NoError(validations.ConfirmIstioCheckMessage("virtualservices.nohost.mirrorhostnotfound", vals[0]))
+	assert.Equal("spec/http[0]/mirror/host", vals[0].Path)
+}
+
+func mirroringVirtualService(mirrorHost string) kubernetes.IstioObject {
+	vs := data.AddRoutesToVirtualService("http", data.CreateRoute("reviews", "v1", -1),
+		data.CreateEmptyVirtualService("reviews", "test", []string{"reviews"}),
+	)
+	vs.GetSpec()["http"].([]interface{})[0].(map[string]interface{})["mirror"] = map[string]interface{}{
+		"host": mirrorHost,
+	}
+	return vs
+}
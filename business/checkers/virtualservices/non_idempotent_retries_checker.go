@@ -0,0 +1,108 @@
+package virtualservices
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/util/intutil"
+)
+
+// nonIdempotentMethods are the HTTP methods whose requests may have side effects when retried
+var nonIdempotentMethods = []string{"POST", "PUT", "PATCH"}
+
+// safeRetryConditions are the retryOn conditions raised before the request reaches the upstream application
+var safeRetryConditions = []string{"connect-failure", "refused-stream"}
+
+type NonIdempotentRetriesChecker struct {
+	VirtualService kubernetes.IstioObject
+}
+
+// Check returns an informational check for each http route matching on a non-idempotent method
+// that configures retries without restricting retryOn to conditions where the request wasn't processed.
+func (n NonIdempotentRetriesChecker) Check() ([]*models.IstioCheck, bool) {
+	validations := make([]*models.IstioCheck, 0)
+
+	httpRoutes, ok := n.VirtualService.GetSpec()["http"].([]interface{})
+	if !ok {
+		return validations, true
+	}
+
+	for routeIdx, httpRoute := range httpRoutes {
+		route, ok := httpRoute.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		retries, ok := route["retries"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if attempts, err := intutil.Convert(retries["attempts"]); err != nil || attempts <= 0 {
+			continue
+		}
+
+		retryOn, _ := retries["retryOn"].(string)
+		if matchesNonIdempotentMethod(route) && !isConservativeRetryOn(retryOn) {
+			validation := models.Build("virtualservices.route.nonidempotentretries", fmt.Sprintf("spec/http[%d]/retries", routeIdx))
+			validations = append(validations, &validation)
+		}
+	}
+
+	return validations, true
+}
+
+func matchesNonIdempotentMethod(route map[string]interface{}) bool {
+	matches, ok := route["match"].([]interface{})
+	if !ok {
+		return false
+	}
+
+	for _, match := range matches {
+		matchMap, ok := match.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		method, ok := matchMap["method"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for _, value := range method {
+			valueStr, ok := value.(string)
+			if !ok {
+				continue
+			}
+			for _, nonIdempotent := range nonIdempotentMethods {
+				if strings.Contains(strings.ToUpper(valueStr), nonIdempotent) {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+// isConservativeRetryOn returns true when all the retryOn conditions are safe ones.
+// An empty retryOn falls back to the Istio defaults, which retry on upstream failures.
+func isConservativeRetryOn(retryOn string) bool {
+	if strings.TrimSpace(retryOn) == "" {
+		return false
+	}
+
+	for _, condition := range strings.Split(retryOn, ",") {
+		safe := false
+		for _, safeCondition := range safeRetryConditions {
+			if strings.TrimSpace(condition) == safeCondition {
+				safe = true
+				break
+			}
+		}
+		if !safe {
+			return false
+		}
+	}
+
+	return true
+}
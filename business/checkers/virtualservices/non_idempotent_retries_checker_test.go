@@ -0,0 +1,60 @@
+package virtualservices
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestRetriesOnGetRoute(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := NonIdempotentRetriesChecker{VirtualService: retriesVirtualService("GET", "")}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestRetriesOnPostRoute(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := NonIdempotentRetriesChecker{VirtualService: retriesVirtualService("POST", "5xx,connect-failure")}.Check()
+
+	assert.True(valid)
+	assert.Len(vals, 1)
+	assert.Equal(models.InfoSeverity, vals[0].Severity)
+	assert.Equal("spec/http[0]/retries", vals[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("virtualservices.route.nonidempotentretries", vals[0]))
+}
+
+func TestConservativeRetriesOnPostRoute(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := NonIdempotentRetriesChecker{VirtualService: retriesVirtualService("POST", "connect-failure,refused-stream")}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func retriesVirtualService(method, retryOn string) kubernetes.IstioObject {
+	vs := data.CreateVirtualService()
+	route := vs.GetSpec()["http"].([]interface{})[0].(map[string]interface{})
+	route["match"] = []interface{}{
+		map[string]interface{}{"method": map[string]interface{}{"exact": method}},
+	}
+	retries := map[string]interface{}{"attempts": 3}
+	if retryOn != "" {
+		retries["retryOn"] = retryOn
+	}
+	route["retries"] = retries
+	return vs
+}
@@ -0,0 +1,76 @@
+package virtualservices
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+type RegexChecker struct {
+	VirtualService kubernetes.IstioObject
+}
+
+// Check returns a warning for each regex of the http match blocks (uri, headers and queryParams)
+// that isn't a valid RE2 expression, as Envoy won't be able to route with it.
+func (r RegexChecker) Check() ([]*models.IstioCheck, bool) {
+	validations := make([]*models.IstioCheck, 0)
+
+	https, ok := r.VirtualService.GetSpec()["http"].([]interface{})
+	if !ok {
+		return validations, true
+	}
+
+	for httpIdx, http := range https {
+		httpRoute, ok := http.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		matches, ok := httpRoute["match"].([]interface{})
+		if !ok {
+			continue
+		}
+		for matchIdx, match := range matches {
+			matchDef, ok := match.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			path := fmt.Sprintf("spec/http[%d]/match[%d]", httpIdx, matchIdx)
+
+			if !isValidRegex(matchDef["uri"]) {
+				validation := models.Build("virtualservices.regex.invalid", path+"/uri/regex")
+				validations = append(validations, &validation)
+			}
+			for _, field := range []string{"headers", "queryParams"} {
+				if stringMatches, ok := matchDef[field].(map[string]interface{}); ok {
+					names := make([]string, 0, len(stringMatches))
+					for name := range stringMatches {
+						names = append(names, name)
+					}
+					sort.Strings(names)
+					for _, name := range names {
+						if !isValidRegex(stringMatches[name]) {
+							validation := models.Build("virtualservices.regex.invalid", fmt.Sprintf("%s/%s/%s/regex", path, field, name))
+							validations = append(validations, &validation)
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return validations, true
+}
+
+// isValidRegex returns false only when the StringMatch defines a regex that doesn't compile
+func isValidRegex(stringMatch interface{}) bool {
+	if stringMatchDef, ok := stringMatch.(map[string]interface{}); ok {
+		if regex, ok := stringMatchDef["regex"].(string); ok {
+			_, err := regexp.Compile(regex)
+			return err == nil
+		}
+	}
+	return true
+}
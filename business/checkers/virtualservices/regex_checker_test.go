@@ -0,0 +1,72 @@
+package virtualservices
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestValidRegex(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := RegexChecker{VirtualService: regexVirtualService(
+		map[string]interface{}{"uri": map[string]interface{}{"regex": "/api/v[0-9]+/.*"}},
+		map[string]interface{}{"headers": map[string]interface{}{"end-user": map[string]interface{}{"regex": "(jason|mike)"}}},
+	)}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestInvalidRegex(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := RegexChecker{VirtualService: regexVirtualService(
+		map[string]interface{}{"uri": map[string]interface{}{"prefix": "/"}},
+		map[string]interface{}{
+			"uri":         map[string]interface{}{"regex": "/api/(v1"},
+			"queryParams": map[string]interface{}{"user": map[string]interface{}{"regex": "(jason"}},
+		},
+	)}.Check()
+
+	assert.True(valid)
+	assert.Len(vals, 2)
+	assert.Equal(models.WarningSeverity, vals[0].Severity)
+	assert.Equal("spec/http[0]/match[1]/uri/regex", vals[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("virtualservices.regex.invalid", vals[0]))
+	assert.Equal("spec/http[0]/match[1]/queryParams/user/regex", vals[1].Path)
+}
+
+func TestNoRegex(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := RegexChecker{VirtualService: regexVirtualService(
+		map[string]interface{}{
+			"uri":     map[string]interface{}{"exact": "/productpage"},
+			"headers": map[string]interface{}{"end-user": map[string]interface{}{"exact": "(jason"}},
+		},
+	)}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func regexVirtualService(matches ...map[string]interface{}) kubernetes.IstioObject {
+	matchList := make([]interface{}, 0, len(matches))
+	for _, match := range matches {
+		matchList = append(matchList, match)
+	}
+
+	vs := data.CreateVirtualService()
+	vs.GetSpec()["http"].([]interface{})[0].(map[string]interface{})["match"] = matchList
+	return vs
+}
@@ -1,6 +1,7 @@
 package virtualservices
 
 import (
+	"fmt"
 	"reflect"
 
 	"github.com/kiali/kiali/config"
@@ -14,13 +15,27 @@ type SingleHostChecker struct {
 	VirtualServices []kubernetes.IstioObject
 }
 
+// hostOwner is a VirtualService owning a host, along with the index of that host in spec/hosts
+type hostOwner struct {
+	virtualService kubernetes.IstioObject
+	hostIndex      int
+}
+
+// Check returns a warning on each host owned by more than one VirtualService for the same gateway, or
+// included into the wildcard host of another VirtualService, as Istio can't merge them.
+// VirtualServices delegating their routes are left out.
 func (s SingleHostChecker) Check() models.IstioValidations {
-	hostCounter := make(map[string]map[string]map[string]map[string][]*kubernetes.IstioObject)
+	hostCounter := make(map[string]map[string]map[string]map[string][]*hostOwner)
 	validations := models.IstioValidations{}
 
 	for _, vs := range s.VirtualServices {
-		for _, host := range s.getHosts(vs) {
-			storeHost(hostCounter, vs, host)
+		if hasDelegate(vs) {
+			continue
+		}
+		for hostIdx, host := range s.getHosts(vs) {
+			if host != nil {
+				storeHost(hostCounter, vs, hostIdx, *host)
+			}
 		}
 	}
 
@@ -31,24 +46,24 @@ func (s SingleHostChecker) Check() models.IstioValidations {
 					isNamespaceWildcard := len(namespaceCounter["*"]) > 0
 					targetSameHost := len(serviceCounter) > 1
 					otherServiceHosts := len(namespaceCounter) > 1
-					for _, virtualService := range serviceCounter {
+					for _, owner := range serviceCounter {
 						// Marking virtualService as invalid if:
 						// - there is more than one virtual service per a host
 						// - there is one virtual service with wildcard and there are other virtual services pointing
 						//   a host for that namespace
 						if targetSameHost {
 							// Reference everything within serviceCounter
-							multipleVirtualServiceCheck(*virtualService, validations, serviceCounter)
+							multipleVirtualServiceCheck(*owner, validations, serviceCounter)
 						}
 
 						if isNamespaceWildcard && otherServiceHosts {
 							// Reference the * or in case of * the other hosts inside namespace
 							// or other stars
-							refs := make([]*kubernetes.IstioObject, 0, len(namespaceCounter))
+							refs := make([]*hostOwner, 0, len(namespaceCounter))
 							for _, serviceCounter := range namespaceCounter {
 								refs = append(refs, serviceCounter...)
 							}
-							multipleVirtualServiceCheck(*virtualService, validations, refs)
+							multipleVirtualServiceCheck(*owner, validations, refs)
 						}
 					}
 				}
@@ -59,10 +74,10 @@ func (s SingleHostChecker) Check() models.IstioValidations {
 	return validations
 }
 
-func multipleVirtualServiceCheck(virtualService kubernetes.IstioObject, validations models.IstioValidations, references []*kubernetes.IstioObject) {
-	virtualServiceName := virtualService.GetObjectMeta().Name
-	key := models.IstioValidationKey{Name: virtualServiceName, Namespace: virtualService.GetObjectMeta().Namespace, ObjectType: "virtualservice"}
-	checks := models.Build("virtualservices.singlehost", "spec/hosts")
+func multipleVirtualServiceCheck(owner hostOwner, validations models.IstioValidations, references []*hostOwner) {
+	virtualServiceName := owner.virtualService.GetObjectMeta().Name
+	key := models.IstioValidationKey{Name: virtualServiceName, Namespace: owner.virtualService.GetObjectMeta().Namespace, ObjectType: "virtualservice"}
+	checks := models.Build("virtualservices.singlehost", fmt.Sprintf("spec/hosts[%d]", owner.hostIndex))
 	rrValidation := &models.IstioValidation{
 		Name:       virtualServiceName,
 		ObjectType: "virtualservice",
@@ -74,7 +89,7 @@ func multipleVirtualServiceCheck(virtualService kubernetes.IstioObject, validati
 	}
 
 	for _, ref := range references {
-		ref := *ref
+		ref := ref.virtualService
 		refKey := models.IstioValidationKey{Name: ref.GetObjectMeta().Name, Namespace: ref.GetObjectMeta().Namespace, ObjectType: "virtualservice"}
 		if refKey != key {
 			rrValidation.References = append(rrValidation.References, refKey)
@@ -84,12 +99,23 @@ func multipleVirtualServiceCheck(virtualService kubernetes.IstioObject, validati
 	validations.MergeValidations(models.IstioValidations{key: rrValidation})
 }
 
-func storeHost(hostCounter map[string]map[string]map[string]map[string][]*kubernetes.IstioObject, vs kubernetes.IstioObject, host kubernetes.Host) {
-	vsList := []*kubernetes.IstioObject{&vs}
+func storeHost(hostCounter map[string]map[string]map[string]map[string][]*hostOwner, vs kubernetes.IstioObject, hostIdx int, host kubernetes.Host) {
+	owner := &hostOwner{virtualService: vs, hostIndex: hostIdx}
+	vsList := []*hostOwner{owner}
 
-	gwList := getGateways(&vs)
+	namespace, clusterName := vs.GetObjectMeta().Namespace, vs.GetObjectMeta().ClusterName
+	if clusterName == "" {
+		clusterName = config.Get().ExternalServices.Istio.IstioIdentityDomain
+	}
+	gwList := make([]string, 0)
+	for _, gw := range getGateways(&vs) {
+		if gw != meshGateway {
+			gw = kubernetes.ParseGatewayAsHost(gw, namespace, clusterName).String()
+		}
+		gwList = append(gwList, gw)
+	}
 	if len(gwList) == 0 {
-		gwList = []string{"no-gateway"}
+		gwList = []string{meshGateway}
 	}
 
 	if !host.CompleteInput {
@@ -99,7 +125,7 @@ func storeHost(hostCounter map[string]map[string]map[string]map[string][]*kubern
 
 	for _, gw := range gwList {
 		if hostCounter[gw] == nil {
-			hostCounter[gw] = map[string]map[string]map[string][]*kubernetes.IstioObject{
+			hostCounter[gw] = map[string]map[string]map[string][]*hostOwner{
 				host.Cluster: {
 					host.Namespace: {
 						host.Service: vsList,
@@ -107,24 +133,25 @@ func storeHost(hostCounter map[string]map[string]map[string]map[string][]*kubern
 				},
 			}
 		} else if hostCounter[gw][host.Cluster] == nil {
-			hostCounter[gw][host.Cluster] = map[string]map[string][]*kubernetes.IstioObject{
+			hostCounter[gw][host.Cluster] = map[string]map[string][]*hostOwner{
 				host.Namespace: {
 					host.Service: vsList,
 				},
 			}
 		} else if hostCounter[gw][host.Cluster][host.Namespace] == nil {
-			hostCounter[gw][host.Cluster][host.Namespace] = map[string][]*kubernetes.IstioObject{
+			hostCounter[gw][host.Cluster][host.Namespace] = map[string][]*hostOwner{
 				host.Service: vsList,
 			}
 		} else if _, ok := hostCounter[gw][host.Cluster][host.Namespace][host.Service]; !ok {
 			hostCounter[gw][host.Cluster][host.Namespace][host.Service] = vsList
 		} else {
-			hostCounter[gw][host.Cluster][host.Namespace][host.Service] = append(hostCounter[gw][host.Cluster][host.Namespace][host.Service], &vs)
+			hostCounter[gw][host.Cluster][host.Namespace][host.Service] = append(hostCounter[gw][host.Cluster][host.Namespace][host.Service], owner)
 		}
 	}
 }
 
-func (s SingleHostChecker) getHosts(virtualService kubernetes.IstioObject) []kubernetes.Host {
+// getHosts returns the hosts of the VirtualService in spec order, nil for the entries that aren't strings
+func (s SingleHostChecker) getHosts(virtualService kubernetes.IstioObject) []*kubernetes.Host {
 	namespace, clusterName := virtualService.GetObjectMeta().Namespace, virtualService.GetObjectMeta().ClusterName
 	if clusterName == "" {
 		clusterName = config.Get().ExternalServices.Istio.IstioIdentityDomain
@@ -132,23 +159,25 @@ func (s SingleHostChecker) getHosts(virtualService kubernetes.IstioObject) []kub
 
 	hosts := virtualService.GetSpec()["hosts"]
 	if hosts == nil {
-		return []kubernetes.Host{}
+		return []*kubernetes.Host{}
 	}
 
 	slice := reflect.ValueOf(hosts)
 	if slice.Kind() != reflect.Slice {
-		return []kubernetes.Host{}
+		return []*kubernetes.Host{}
 	}
 
-	targetHosts := make([]kubernetes.Host, 0, slice.Len())
+	targetHosts := make([]*kubernetes.Host, 0, slice.Len())
 
 	for hostIdx := 0; hostIdx < slice.Len(); hostIdx++ {
 		hostName, ok := slice.Index(hostIdx).Interface().(string)
 		if !ok {
+			targetHosts = append(targetHosts, nil)
 			continue
 		}
 
-		targetHosts = append(targetHosts, kubernetes.GetHost(hostName, namespace, clusterName, s.Namespaces.GetNames()))
+		host := kubernetes.GetHost(hostName, namespace, clusterName, s.Namespaces.GetNames())
+		targetHosts = append(targetHosts, &host)
 	}
 
 	return targetHosts
@@ -175,3 +204,16 @@ func getGateways(virtualService *kubernetes.IstioObject) []string {
 
 	return gateways
 }
+
+func hasDelegate(virtualService kubernetes.IstioObject) bool {
+	if routes, ok := virtualService.GetSpec()["http"].([]interface{}); ok {
+		for _, r := range routes {
+			if route, ok := r.(map[string]interface{}); ok {
+				if _, ok := route["delegate"]; ok {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
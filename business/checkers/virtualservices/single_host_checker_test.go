@@ -458,6 +458,48 @@ func TestMultipleHostsPassing(t *testing.T) {
 	emptyValidationTest(t, vals)
 }
 
+func TestRepeatingHostPath(t *testing.T) {
+	assert := assert.New(t)
+
+	vals := SingleHostChecker{
+		Namespace: "bookinfo",
+		VirtualServices: []kubernetes.IstioObject{
+			data.AddGatewaysToVirtualService([]string{"bookinfo-gateway"},
+				data.CreateEmptyVirtualService("virtual-1", "bookinfo", []string{"ratings", "reviews.bookinfo.svc.cluster.local"})),
+			buildVirtualServiceWithGateway("virtual-2", "reviews", "bookinfo/bookinfo-gateway"),
+		},
+	}.Check()
+
+	assert.Len(vals, 2)
+
+	validation, ok := vals[models.IstioValidationKey{ObjectType: "virtualservice", Namespace: "bookinfo", Name: "virtual-1"}]
+	if assert.True(ok) && assert.Len(validation.Checks, 1) {
+		assert.Equal("spec/hosts[1]", validation.Checks[0].Path)
+		assert.Equal([]models.IstioValidationKey{{ObjectType: "virtualservice", Namespace: "bookinfo", Name: "virtual-2"}}, validation.References)
+	}
+
+	validation, ok = vals[models.IstioValidationKey{ObjectType: "virtualservice", Namespace: "bookinfo", Name: "virtual-2"}]
+	if assert.True(ok) && assert.Len(validation.Checks, 1) {
+		assert.Equal("spec/hosts[0]", validation.Checks[0].Path)
+	}
+}
+
+func TestRepeatingHostDelegate(t *testing.T) {
+	delegating := buildVirtualService("virtual-1", "reviews")
+	delegating.GetSpec()["http"] = []interface{}{
+		map[string]interface{}{
+			"delegate": map[string]interface{}{"name": "reviews-delegate", "namespace": "bookinfo"},
+		},
+	}
+
+	vals := SingleHostChecker{
+		Namespace:       "bookinfo",
+		VirtualServices: []kubernetes.IstioObject{delegating, buildVirtualService("virtual-2", "reviews")},
+	}.Check()
+
+	emptyValidationTest(t, vals)
+}
+
 func buildVirtualService(name, host string) kubernetes.IstioObject {
 	return buildVirtualServiceMultipleHosts(name, []string{host})
 }
@@ -503,7 +545,7 @@ func presentValidationTest(t *testing.T, vals models.IstioValidations, serviceNa
 	assert.NotEmpty(validation.Checks)
 	assert.Equal(models.WarningSeverity, validation.Checks[0].Severity)
 	assert.NoError(validations.ConfirmIstioCheckMessage("virtualservices.singlehost", validation.Checks[0]))
-	assert.Equal("spec/hosts", validation.Checks[0].Path)
+	assert.Regexp(`^spec/hosts\[\d+\]$`, validation.Checks[0].Path)
 }
 
 func presentReference(t *testing.T, validation models.IstioValidation, serviceName string) {
@@ -6,6 +6,7 @@ import (
 
 	"github.com/kiali/kiali/kubernetes"
 	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/util/intutil"
 )
 
 type SubsetPresenceChecker struct {
@@ -68,7 +69,12 @@ func (checker SubsetPresenceChecker) Check() ([]*models.IstioCheck, bool) {
 				if !checker.subsetPresent(host, subset) {
 					path := fmt.Sprintf("spec/%s[%d]/route[%d]/destination", protocol, routeIdx, destWeightIdx)
 					validation := models.Build("virtualservices.subsetpresent.subsetnotfound", path)
+					// Within a weighted split, only the share of the traffic sent to the missing subset fails
+					if weight, err := intutil.Convert(destinationWeight["weight"]); err == nil && weight > 0 && destinationWeights.Len() > 1 {
+						validation.Remediation = fmt.Sprintf("Define subset %s in a DestinationRule for host %s: %d%% of the traffic split fails", subset, host, weight)
+					}
 					validations = append(validations, &validation)
+					valid = false
 				}
 			}
 		}
@@ -77,6 +83,64 @@ func (checker SubsetPresenceChecker) Check() ([]*models.IstioCheck, bool) {
 	return validations, valid
 }
 
+// MirrorSubsetPresenceChecker validates the subsets of the http mirrors. It's kept apart from the
+// SubsetPresenceChecker as a missing mirror subset drops the mirrored traffic only, not the routed one.
+type MirrorSubsetPresenceChecker struct {
+	Namespace        string
+	Namespaces       []string
+	DestinationRules []kubernetes.IstioObject
+	VirtualService   kubernetes.IstioObject
+}
+
+// Check returns an error for each http mirror, set through mirror or mirrors, whose subset isn't defined by any DestinationRule
+func (checker MirrorSubsetPresenceChecker) Check() ([]*models.IstioCheck, bool) {
+	validations := make([]*models.IstioCheck, 0)
+	presenceChecker := SubsetPresenceChecker(checker)
+
+	httpRoutes, ok := checker.VirtualService.GetSpec()["http"].([]interface{})
+	if !ok {
+		return validations, true
+	}
+
+	for routeIdx, httpRoute := range httpRoutes {
+		httpRouteCasted, ok := httpRoute.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if mirror, ok := httpRouteCasted["mirror"].(map[string]interface{}); ok && !presenceChecker.destinationSubsetPresent(mirror) {
+			validation := models.Build("virtualservices.mirror.subsetnotfound", fmt.Sprintf("spec/http[%d]/mirror", routeIdx))
+			validations = append(validations, &validation)
+		}
+
+		if mirrors, ok := httpRouteCasted["mirrors"].([]interface{}); ok {
+			for mirrorIdx, mirror := range mirrors {
+				if mirrorCasted, ok := mirror.(map[string]interface{}); ok {
+					if destination, ok := mirrorCasted["destination"].(map[string]interface{}); ok && !presenceChecker.destinationSubsetPresent(destination) {
+						validation := models.Build("virtualservices.mirror.subsetnotfound", fmt.Sprintf("spec/http[%d]/mirrors[%d]/destination", routeIdx, mirrorIdx))
+						validations = append(validations, &validation)
+					}
+				}
+			}
+		}
+	}
+
+	return validations, len(validations) == 0
+}
+
+// destinationSubsetPresent returns false only when the destination sets a subset not defined by any DestinationRule
+func (checker SubsetPresenceChecker) destinationSubsetPresent(destination map[string]interface{}) bool {
+	host, ok := destination["host"].(string)
+	if !ok {
+		return true
+	}
+	subset, ok := destination["subset"].(string)
+	if !ok {
+		return true
+	}
+	return checker.subsetPresent(host, subset)
+}
+
 func (checker SubsetPresenceChecker) subsetPresent(host string, subset string) bool {
 	destinationRules, ok := checker.getDestinationRules(host)
 	if !ok || destinationRules == nil || len(destinationRules) == 0 {
@@ -123,11 +187,8 @@ func hasSubsetDefined(destinationRule kubernetes.IstioObject, subsetTarget strin
 		if dSubsets, ok := subsets.([]interface{}); ok {
 			for _, subset := range dSubsets {
 				if innerSubset, ok := subset.(map[string]interface{}); ok {
-					if sSubsetName := innerSubset["name"]; ok {
-						subsetName := sSubsetName.(string)
-						if subsetName == subsetTarget {
-							return true
-						}
+					if subsetName, ok := innerSubset["name"].(string); ok && subsetName == subsetTarget {
+						return true
 					}
 				}
 			}
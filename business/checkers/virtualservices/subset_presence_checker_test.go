@@ -4,9 +4,12 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+
 	"github.com/kiali/kiali/config"
 	"github.com/kiali/kiali/kubernetes"
 	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
 	"github.com/kiali/kiali/tests/testutils/validations"
 )
 
@@ -54,9 +57,9 @@ func TestInvalidServiceEntry(t *testing.T) {
 	vals, valid := subsetPresenceCheckerPrep("subset-presence-service-entry-invalid.yaml", t)
 
 	tb := validations.IstioCheckTestAsserter{T: t, Validations: vals, Valid: valid}
-	tb.AssertValidationsPresent(2, true)
-	tb.AssertValidationAt(0, models.WarningSeverity, "spec/http[1]/route[0]/destination", "virtualservices.subsetpresent.subsetnotfound")
-	tb.AssertValidationAt(1, models.WarningSeverity, "spec/tls[1]/route[0]/destination", "virtualservices.subsetpresent.subsetnotfound")
+	tb.AssertValidationsPresent(2, false)
+	tb.AssertValidationAt(0, models.ErrorSeverity, "spec/http[1]/route[0]/destination", "virtualservices.subsetpresent.subsetnotfound")
+	tb.AssertValidationAt(1, models.ErrorSeverity, "spec/tls[1]/route[0]/destination", "virtualservices.subsetpresent.subsetnotfound")
 }
 
 func subsetPresenceCheckerPrep(scenario string, t *testing.T) ([]*models.IstioCheck, bool) {
@@ -103,7 +106,95 @@ func testSubsetPresenceValidationsFound(scenario string, t *testing.T) {
 	vals, valid := subsetPresenceCheckerPrep(scenario, t)
 
 	tb := validations.IstioCheckTestAsserter{T: t, Validations: vals, Valid: valid}
-	tb.AssertValidationsPresent(2, true)
-	tb.AssertValidationAt(0, models.WarningSeverity, "spec/http[0]/route[0]/destination", "virtualservices.subsetpresent.subsetnotfound")
-	tb.AssertValidationAt(1, models.WarningSeverity, "spec/http[1]/route[0]/destination", "virtualservices.subsetpresent.subsetnotfound")
+	tb.AssertValidationsPresent(2, false)
+	tb.AssertValidationAt(0, models.ErrorSeverity, "spec/http[0]/route[0]/destination", "virtualservices.subsetpresent.subsetnotfound")
+	tb.AssertValidationAt(1, models.ErrorSeverity, "spec/http[1]/route[0]/destination", "virtualservices.subsetpresent.subsetnotfound")
+}
+
+func TestMirrorSubsetPresent(t *testing.T) {
+	vals, valid := mirrorSubsetPresenceCheckerPrep("mirror-subset-present.yaml", t)
+	tb := validations.IstioCheckTestAsserter{T: t, Validations: vals, Valid: valid}
+	tb.AssertNoValidations()
+}
+
+func TestMirrorSubsetNotFound(t *testing.T) {
+	vals, valid := mirrorSubsetPresenceCheckerPrep("mirror-subset-not-found.yaml", t)
+
+	tb := validations.IstioCheckTestAsserter{T: t, Validations: vals, Valid: valid}
+	tb.AssertValidationsPresent(2, false)
+	tb.AssertValidationAt(0, models.ErrorSeverity, "spec/http[0]/mirror", "virtualservices.mirror.subsetnotfound")
+	tb.AssertValidationAt(1, models.ErrorSeverity, "spec/http[0]/mirrors[1]/destination", "virtualservices.mirror.subsetnotfound")
+}
+
+func TestMirrorWithoutSubset(t *testing.T) {
+	vals, valid := mirrorSubsetPresenceCheckerPrep("mirror-no-subset.yaml", t)
+	tb := validations.IstioCheckTestAsserter{T: t, Validations: vals, Valid: valid}
+	tb.AssertNoValidations()
+}
+
+func mirrorSubsetPresenceCheckerPrep(scenario string, t *testing.T) ([]*models.IstioCheck, bool) {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	loader := yamlFixtureLoaderFor(scenario)
+	if err := loader.Load(); err != nil {
+		t.Error("Error loading test data.")
+	}
+
+	return MirrorSubsetPresenceChecker{
+		Namespace:        "bookinfo",
+		Namespaces:       namespaceNames(loader.GetResources("Namespace")),
+		DestinationRules: loader.GetResources("DestinationRule"),
+		VirtualService:   loader.GetFirstResource("VirtualService"),
+	}.Check()
+}
+
+func TestWeightedSplitMissingSubset(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vs := data.AddRoutesToVirtualService("http", data.CreateRoute("reviews", "v2", 25),
+		data.AddRoutesToVirtualService("http", data.CreateRoute("reviews", "v1", 75),
+			data.CreateEmptyVirtualService("reviews", "bookinfo", []string{"reviews"}),
+		),
+	)
+
+	vals, valid := SubsetPresenceChecker{
+		Namespace:        "bookinfo",
+		Namespaces:       []string{"bookinfo"},
+		DestinationRules: []kubernetes.IstioObject{reviewsV1DestinationRule()},
+		VirtualService:   vs,
+	}.Check()
+
+	assert.False(valid)
+	assert.Len(vals, 1)
+	assert.Equal(models.ErrorSeverity, vals[0].Severity)
+	assert.Equal("spec/http[0]/route[1]/destination", vals[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("virtualservices.subsetpresent.subsetnotfound", vals[0]))
+	assert.Equal("Define subset v2 in a DestinationRule for host reviews: 25% of the traffic split fails", vals[0].Remediation)
+}
+
+func TestSingleDestinationMissingSubset(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vs := data.AddRoutesToVirtualService("http", data.CreateRoute("reviews", "v2", 100),
+		data.CreateEmptyVirtualService("reviews", "bookinfo", []string{"reviews"}))
+
+	vals, valid := SubsetPresenceChecker{
+		Namespace:        "bookinfo",
+		Namespaces:       []string{"bookinfo"},
+		DestinationRules: []kubernetes.IstioObject{reviewsV1DestinationRule()},
+		VirtualService:   vs,
+	}.Check()
+
+	// Not a split, all the traffic fails
+	assert.False(valid)
+	assert.Len(vals, 1)
+	assert.Empty(vals[0].Remediation)
+}
+
+func reviewsV1DestinationRule() kubernetes.IstioObject {
+	return data.AddSubsetToDestinationRule(data.CreateSubset("v1", "v1"),
+		data.CreateEmptyDestinationRule("bookinfo", "reviews", "reviews"))
 }
@@ -0,0 +1,113 @@
+package virtualservices
+
+import (
+	"fmt"
+
+	core_v1 "k8s.io/api/core/v1"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+// httpRouteFeatures are the HTTPRoute fields that only apply to HTTP traffic
+var httpRouteFeatures = []string{"timeout", "retries", "fault", "mirror", "rewrite", "redirect", "corsPolicy", "headers"}
+
+type TCPOnlyHostChecker struct {
+	Namespaces     models.Namespaces
+	Services       []core_v1.Service
+	VirtualService kubernetes.IstioObject
+}
+
+// Check returns an informational check for each http route using HTTP-only settings
+// when all its destination hosts are Services that only expose TCP or TLS ports.
+func (t TCPOnlyHostChecker) Check() ([]*models.IstioCheck, bool) {
+	validations := make([]*models.IstioCheck, 0)
+
+	httpRoutes, ok := t.VirtualService.GetSpec()["http"].([]interface{})
+	if !ok {
+		return validations, true
+	}
+
+	for routeIdx, httpRoute := range httpRoutes {
+		route, ok := httpRoute.(map[string]interface{})
+		if !ok || !hasHTTPRouteFeatures(route) {
+			continue
+		}
+
+		hosts := destinationHosts(route)
+		if len(hosts) == 0 {
+			continue
+		}
+
+		tcpOnly := true
+		for _, host := range hosts {
+			if !t.isTCPOnlyHost(host) {
+				tcpOnly = false
+				break
+			}
+		}
+
+		if tcpOnly {
+			validation := models.Build("virtualservices.route.tcponlyhost", fmt.Sprintf("spec/http[%d]", routeIdx))
+			validations = append(validations, &validation)
+		}
+	}
+
+	return validations, true
+}
+
+func (t TCPOnlyHostChecker) isTCPOnlyHost(host string) bool {
+	namespace, clusterName := t.VirtualService.GetObjectMeta().Namespace, t.VirtualService.GetObjectMeta().ClusterName
+	fqdn := kubernetes.GetHost(host, namespace, clusterName, t.Namespaces.GetNames())
+	if fqdn.Namespace != namespace {
+		return false
+	}
+
+	for _, svc := range t.Services {
+		if svc.Name == fqdn.Service {
+			return isTCPOnlyService(svc)
+		}
+	}
+	return false
+}
+
+// isTCPOnlyService returns true when every port of the Service declares a non-HTTP protocol.
+// Ports left to protocol auto-detection might carry HTTP traffic.
+func isTCPOnlyService(svc core_v1.Service) bool {
+	if len(svc.Spec.Ports) == 0 {
+		return false
+	}
+
+	for _, port := range models.ResolveInboundPortProtocols(svc) {
+		switch port.Protocol {
+		case "", "http", "http2", "grpc", "grpc-web":
+			return false
+		}
+	}
+	return true
+}
+
+func hasHTTPRouteFeatures(route map[string]interface{}) bool {
+	for _, feature := range httpRouteFeatures {
+		if _, found := route[feature]; found {
+			return true
+		}
+	}
+	return false
+}
+
+func destinationHosts(route map[string]interface{}) []string {
+	hosts := make([]string, 0)
+	if destinations, ok := route["route"].([]interface{}); ok {
+		for _, d := range destinations {
+			if destinationWeight, ok := d.(map[string]interface{}); ok {
+				if destination, ok := destinationWeight["destination"].(map[string]interface{}); ok {
+					if host, ok := destination["host"].(string); ok {
+						hosts = append(hosts, host)
+					}
+				}
+			}
+		}
+	}
+	return hosts
+}
@@ -0,0 +1,96 @@
+package virtualservices
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	core_v1 "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestHTTPTimeoutOnHTTPHost(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := TCPOnlyHostChecker{
+		Services:       []core_v1.Service{fakeServiceWithPorts("reviews", "http", "tcp-metrics")},
+		VirtualService: timeoutVirtualService("reviews"),
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestHTTPTimeoutOnTCPOnlyHost(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := TCPOnlyHostChecker{
+		Services:       []core_v1.Service{fakeServiceWithPorts("mongodb", "tcp", "tls-secure")},
+		VirtualService: timeoutVirtualService("mongodb"),
+	}.Check()
+
+	assert.True(valid)
+	assert.Len(vals, 1)
+	assert.Equal(models.InfoSeverity, vals[0].Severity)
+	assert.Equal("spec/http[0]", vals[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("virtualservices.route.tcponlyhost", vals[0]))
+}
+
+func TestHTTPTimeoutOnAutoDetectedHost(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := TCPOnlyHostChecker{
+		Services:       []core_v1.Service{fakeServiceWithPorts("mongodb", "tcp", "web")},
+		VirtualService: timeoutVirtualService("mongodb"),
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestHTTPRouteWithoutFeaturesOnTCPOnlyHost(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vs := data.AddRoutesToVirtualService("http", data.CreateRoute("mongodb", "v1", -1),
+		data.CreateEmptyVirtualService("mongodb", "bookinfo", []string{"mongodb"}))
+
+	vals, valid := TCPOnlyHostChecker{
+		Services:       []core_v1.Service{fakeServiceWithPorts("mongodb", "tcp")},
+		VirtualService: vs,
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func timeoutVirtualService(host string) kubernetes.IstioObject {
+	vs := data.AddRoutesToVirtualService("http", data.CreateRoute(host, "v1", -1),
+		data.CreateEmptyVirtualService(host, "bookinfo", []string{host}))
+	vs.GetSpec()["http"].([]interface{})[0].(map[string]interface{})["timeout"] = "5s"
+	return vs
+}
+
+func fakeServiceWithPorts(name string, portNames ...string) core_v1.Service {
+	ports := make([]core_v1.ServicePort, 0, len(portNames))
+	for i, portName := range portNames {
+		ports = append(ports, core_v1.ServicePort{Name: portName, Port: int32(9080 + i)})
+	}
+	return core_v1.Service{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name:      name,
+			Namespace: "bookinfo",
+		},
+		Spec: core_v1.ServiceSpec{
+			Ports: ports,
+		},
+	}
+}
@@ -0,0 +1,60 @@
+package virtualservices
+
+import (
+	"fmt"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/util/intutil"
+)
+
+type WeightChecker struct {
+	VirtualService kubernetes.IstioObject
+}
+
+// Check returns an error for each http, tcp or tls route with several weighted destinations
+// whose weights don't add up to 100. Routes with a single destination are assumed to take all the traffic.
+func (w WeightChecker) Check() ([]*models.IstioCheck, bool) {
+	validations := make([]*models.IstioCheck, 0)
+
+	for _, protocol := range []string{"http", "tcp", "tls"} {
+		routes, ok := w.VirtualService.GetSpec()[protocol].([]interface{})
+		if !ok {
+			continue
+		}
+		for routeIdx, route := range routes {
+			routeDef, ok := route.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			destinationWeights, ok := routeDef["route"].([]interface{})
+			if !ok || len(destinationWeights) < 2 {
+				continue
+			}
+
+			sum, weighted := 0, false
+			for _, destinationWeight := range destinationWeights {
+				if destinationWeightDef, ok := destinationWeight.(map[string]interface{}); ok && destinationWeightDef["weight"] != nil {
+					if weight, err := intutil.Convert(destinationWeightDef["weight"]); err == nil {
+						sum += weight
+						weighted = true
+					}
+				}
+			}
+			if !weighted {
+				continue
+			}
+
+			path := fmt.Sprintf("spec/%s[%d]/route", protocol, routeIdx)
+			if sum < 100 {
+				validation := models.Build("virtualservices.route.weightssumlessthan100", path)
+				validations = append(validations, &validation)
+			} else if sum > 100 {
+				validation := models.Build("virtualservices.route.weightssummorethan100", path)
+				validations = append(validations, &validation)
+			}
+		}
+	}
+
+	return validations, len(validations) == 0
+}
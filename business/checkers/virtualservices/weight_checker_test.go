@@ -0,0 +1,66 @@
+package virtualservices
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestWeightsSum100(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := WeightChecker{VirtualService: weightedVirtualService(25, 75)}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestWeightsSumLessThan100(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := WeightChecker{VirtualService: weightedVirtualService(25, 70)}.Check()
+
+	assert.False(valid)
+	assert.Len(vals, 1)
+	assert.Equal(models.ErrorSeverity, vals[0].Severity)
+	assert.Equal("spec/http[0]/route", vals[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("virtualservices.route.weightssumlessthan100", vals[0]))
+}
+
+func TestWeightsSumMoreThan100(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := WeightChecker{VirtualService: weightedVirtualService(60, 60)}.Check()
+
+	assert.False(valid)
+	assert.Len(vals, 1)
+	assert.Equal("spec/http[0]/route", vals[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("virtualservices.route.weightssummorethan100", vals[0]))
+}
+
+func TestWeightsSingleDestination(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := WeightChecker{VirtualService: weightedVirtualService(-1)}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func weightedVirtualService(weights ...int64) kubernetes.IstioObject {
+	vs := data.CreateEmptyVirtualService("reviews", "test", []string{"reviews"})
+	for i, weight := range weights {
+		vs = data.AddRoutesToVirtualService("http", data.CreateRoute("reviews", "v"+string(rune('1'+i)), weight), vs)
+	}
+	return vs
+}
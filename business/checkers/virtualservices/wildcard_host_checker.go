@@ -0,0 +1,50 @@
+package virtualservices
+
+import (
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+type WildcardHostChecker struct {
+	VirtualService kubernetes.IstioObject
+}
+
+// Check validates that a VirtualService with the '*' host is only bound to gateways.
+// A VirtualService without gateways, or listing the reserved 'mesh' gateway, applies to every sidecar in the mesh.
+func (w WildcardHostChecker) Check() ([]*models.IstioCheck, bool) {
+	validations := make([]*models.IstioCheck, 0)
+
+	if !w.hasWildcardHost() || !w.appliesToMesh() {
+		return validations, true
+	}
+
+	validation := models.Build("virtualservices.wildcardhost.mesh", "spec/hosts")
+	validations = append(validations, &validation)
+
+	return validations, false
+}
+
+func (w WildcardHostChecker) hasWildcardHost() bool {
+	if hosts, ok := w.VirtualService.GetSpec()["hosts"].([]interface{}); ok {
+		for _, h := range hosts {
+			if host, ok := h.(string); ok && host == "*" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (w WildcardHostChecker) appliesToMesh() bool {
+	gateways, ok := w.VirtualService.GetSpec()["gateways"].([]interface{})
+	if !ok || len(gateways) == 0 {
+		return true
+	}
+
+	for _, g := range gateways {
+		if gate, ok := g.(string); ok && gate == "mesh" {
+			return true
+		}
+	}
+	return false
+}
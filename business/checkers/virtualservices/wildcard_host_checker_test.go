@@ -0,0 +1,61 @@
+package virtualservices
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestWildcardHostBoundToGateway(t *testing.T) {
+	assert := assert.New(t)
+
+	vs := data.AddGatewaysToVirtualService([]string{"bookinfo/bookinfo-gateway"},
+		data.CreateEmptyVirtualService("wildcard", "bookinfo", []string{"*"}))
+
+	vals, valid := WildcardHostChecker{VirtualService: vs}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestWildcardHostWithoutGateway(t *testing.T) {
+	assert := assert.New(t)
+
+	vs := data.CreateEmptyVirtualService("wildcard", "bookinfo", []string{"*"})
+
+	vals, valid := WildcardHostChecker{VirtualService: vs}.Check()
+
+	assert.False(valid)
+	assert.Len(vals, 1)
+	assert.Equal(models.ErrorSeverity, vals[0].Severity)
+	assert.Equal("spec/hosts", vals[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("virtualservices.wildcardhost.mesh", vals[0]))
+}
+
+func TestWildcardHostWithMeshGateway(t *testing.T) {
+	assert := assert.New(t)
+
+	vs := data.AddGatewaysToVirtualService([]string{"bookinfo/bookinfo-gateway", "mesh"},
+		data.CreateEmptyVirtualService("wildcard", "bookinfo", []string{"*"}))
+
+	vals, valid := WildcardHostChecker{VirtualService: vs}.Check()
+
+	assert.False(valid)
+	assert.Len(vals, 1)
+	assert.NoError(validations.ConfirmIstioCheckMessage("virtualservices.wildcardhost.mesh", vals[0]))
+}
+
+func TestNonWildcardHostWithoutGateway(t *testing.T) {
+	assert := assert.New(t)
+
+	vals, valid := WildcardHostChecker{
+		VirtualService: data.CreateEmptyVirtualService("reviews", "bookinfo", []string{"reviews"}),
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
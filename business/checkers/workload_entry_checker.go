@@ -0,0 +1,45 @@
+package checkers
+
+import (
+	core_v1 "k8s.io/api/core/v1"
+
+	"github.com/kiali/kiali/business/checkers/workloadentries"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+const WorkloadEntryCheckerType = "workloadentry"
+
+type WorkloadEntryChecker struct {
+	WorkloadEntries  []kubernetes.IstioObject
+	DestinationRules []kubernetes.IstioObject
+	Namespaces       models.Namespaces
+	Services         []core_v1.Service
+}
+
+func (w WorkloadEntryChecker) Check() models.IstioValidations {
+	validations := models.IstioValidations{}
+
+	for _, workloadEntry := range w.WorkloadEntries {
+		validations.MergeValidations(w.runChecks(workloadEntry))
+	}
+
+	return validations
+}
+
+// runChecks runs all the individual checks for a single workload entry and appends the result into validations.
+func (w WorkloadEntryChecker) runChecks(workloadEntry kubernetes.IstioObject) models.IstioValidations {
+	key, rrValidation := EmptyValidValidation(workloadEntry.GetObjectMeta().Name, workloadEntry.GetObjectMeta().Namespace, WorkloadEntryCheckerType)
+
+	enabledCheckers := []Checker{
+		workloadentries.SubsetLabelsChecker{WorkloadEntry: workloadEntry, DestinationRules: w.DestinationRules, Namespaces: w.Namespaces.GetNames(), Services: w.Services},
+	}
+
+	for _, checker := range enabledCheckers {
+		checks, validChecker := checker.Check()
+		rrValidation.Checks = append(rrValidation.Checks, checks...)
+		rrValidation.Valid = rrValidation.Valid && validChecker
+	}
+
+	return models.IstioValidations{key: rrValidation}
+}
@@ -0,0 +1,91 @@
+package workloadentries
+
+import (
+	core_v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/kiali/kiali/business/checkers/destinationrules"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+type SubsetLabelsChecker struct {
+	WorkloadEntry    kubernetes.IstioObject
+	DestinationRules []kubernetes.IstioObject
+	Namespaces       []string
+	Services         []core_v1.Service
+}
+
+// Check returns a warning when the WorkloadEntry is selected by a Service whose DestinationRules define subsets,
+// but none of those subsets selects the WorkloadEntry labels, as it won't receive any subset routed traffic.
+func (s SubsetLabelsChecker) Check() ([]*models.IstioCheck, bool) {
+	validations := make([]*models.IstioCheck, 0)
+
+	weLabels := labels.Set{}
+	if specLabels, ok := s.WorkloadEntry.GetSpec()["labels"].(map[string]interface{}); ok {
+		for k, v := range specLabels {
+			if value, ok := v.(string); ok {
+				weLabels[k] = value
+			}
+		}
+	}
+	if len(weLabels) == 0 {
+		return validations, true
+	}
+
+	hasSubsets, matched := false, false
+	for _, svc := range s.selectingServices(weLabels) {
+		for _, dr := range s.DestinationRules {
+			if !s.targetsService(dr, svc) {
+				continue
+			}
+			subsets, ok := dr.GetSpec()["subsets"].([]interface{})
+			if !ok {
+				continue
+			}
+			for _, subset := range subsets {
+				subsetDef, ok := subset.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				if subsetLabels, ok := destinationrules.SubsetLabels(subsetDef); ok {
+					hasSubsets = true
+					if labels.SelectorFromSet(labels.Set(subsetLabels)).Matches(weLabels) {
+						matched = true
+					}
+				}
+			}
+		}
+	}
+
+	if hasSubsets && !matched {
+		validation := models.Build("workloadentries.labels.nosubsetmatch", "spec/labels")
+		validations = append(validations, &validation)
+	}
+
+	return validations, true
+}
+
+// selectingServices returns the services of the WorkloadEntry namespace whose selector matches its labels
+func (s SubsetLabelsChecker) selectingServices(weLabels labels.Set) []core_v1.Service {
+	services := make([]core_v1.Service, 0)
+	for _, svc := range s.Services {
+		if svc.Namespace != s.WorkloadEntry.GetObjectMeta().Namespace || len(svc.Spec.Selector) == 0 {
+			continue
+		}
+		if labels.SelectorFromSet(labels.Set(svc.Spec.Selector)).Matches(weLabels) {
+			services = append(services, svc)
+		}
+	}
+	return services
+}
+
+func (s SubsetLabelsChecker) targetsService(dr kubernetes.IstioObject, svc core_v1.Service) bool {
+	host, ok := dr.GetSpec()["host"].(string)
+	if !ok {
+		return false
+	}
+	meta := dr.GetObjectMeta()
+	drHost := kubernetes.GetHost(host, meta.Namespace, meta.ClusterName, s.Namespaces)
+	return drHost.Service == svc.Name && drHost.Namespace == svc.Namespace
+}
@@ -0,0 +1,94 @@
+package workloadentries
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	core_v1 "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestWorkloadEntryMatchingSubset(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := SubsetLabelsChecker{
+		WorkloadEntry:    workloadEntry(map[string]interface{}{"app": "reviews", "version": "v1"}),
+		DestinationRules: []kubernetes.IstioObject{reviewsSubsetsDestinationRule()},
+		Namespaces:       []string{"bookinfo"},
+		Services:         []core_v1.Service{reviewsService()},
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestWorkloadEntryNoMatchingSubset(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := SubsetLabelsChecker{
+		WorkloadEntry:    workloadEntry(map[string]interface{}{"app": "reviews", "version": "vm"}),
+		DestinationRules: []kubernetes.IstioObject{reviewsSubsetsDestinationRule()},
+		Namespaces:       []string{"bookinfo"},
+		Services:         []core_v1.Service{reviewsService()},
+	}.Check()
+
+	assert.True(valid)
+	assert.Len(vals, 1)
+	assert.Equal(models.WarningSeverity, vals[0].Severity)
+	assert.Equal("spec/labels", vals[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("workloadentries.labels.nosubsetmatch", vals[0]))
+}
+
+func TestWorkloadEntryWithoutSubsets(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := SubsetLabelsChecker{
+		WorkloadEntry:    workloadEntry(map[string]interface{}{"app": "reviews", "version": "vm"}),
+		DestinationRules: []kubernetes.IstioObject{data.CreateEmptyDestinationRule("bookinfo", "reviews", "reviews")},
+		Namespaces:       []string{"bookinfo"},
+		Services:         []core_v1.Service{reviewsService()},
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func reviewsSubsetsDestinationRule() kubernetes.IstioObject {
+	return data.AddSubsetToDestinationRule(data.CreateSubset("v1", "v1"),
+		data.AddSubsetToDestinationRule(data.CreateSubset("v2", "v2"),
+			data.CreateEmptyDestinationRule("bookinfo", "reviews", "reviews")))
+}
+
+func reviewsService() core_v1.Service {
+	return core_v1.Service{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name:      "reviews",
+			Namespace: "bookinfo",
+		},
+		Spec: core_v1.ServiceSpec{
+			Selector: map[string]string{"app": "reviews"},
+		},
+	}
+}
+
+func workloadEntry(labels map[string]interface{}) kubernetes.IstioObject {
+	return (&kubernetes.GenericIstioObject{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name:      "reviews-vm",
+			Namespace: "bookinfo",
+		},
+		Spec: map[string]interface{}{
+			"address": "10.0.0.1",
+			"labels":  labels,
+		},
+	}).DeepCopyIstioObject()
+}
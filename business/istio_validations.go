@@ -2,13 +2,17 @@ package business
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 	"sync"
+	"time"
 
 	apps_v1 "k8s.io/api/apps/v1"
 	core_v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 
 	"github.com/kiali/kiali/business/checkers"
+	"github.com/kiali/kiali/business/checkers/gateways"
 	"github.com/kiali/kiali/config"
 	"github.com/kiali/kiali/kubernetes"
 	"github.com/kiali/kiali/log"
@@ -27,6 +31,180 @@ type ObjectChecker interface {
 // GetValidations returns an IstioValidations object with all the checks found when running
 // all the enabled checkers. If service is "" then the whole namespace is validated.
 func (in *IstioValidationsService) GetValidations(namespace, service string) (models.IstioValidations, error) {
+	return in.getValidations(namespace, service, "", nil)
+}
+
+// GetValidationsDelta returns the validations introduced (added) and resolved (removed) in the namespace
+// when the proposed object of objectType is applied on top of its current state.
+// The proposed object replaces any existing object of the same type, namespace and name.
+func (in *IstioValidationsService) GetValidationsDelta(namespace, objectType string, proposed kubernetes.IstioObject) (models.IstioValidations, models.IstioValidations, error) {
+	current, err := in.getValidations(namespace, "", "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	withProposed, err := in.getValidations(namespace, "", objectType, proposed)
+	if err != nil {
+		return nil, nil, err
+	}
+	return withProposed.Diff(current), current.Diff(withProposed), nil
+}
+
+// GetServiceDeletionImpact returns the VirtualServices and DestinationRules of all the accessible namespaces that
+// reference the service host, i.e. the objects that would be left dangling if the service was deleted.
+func (in *IstioValidationsService) GetServiceDeletionImpact(namespace, service string) ([]kubernetes.IstioObject, error) {
+	if service == "" {
+		return nil, fmt.Errorf("Service name is required to resolve the objects referencing it")
+	}
+
+	// Check if user has access to the namespace (RBAC) in cache scenarios and/or
+	// if namespace is accessible from Kiali (Deployment.AccessibleNamespaces)
+	if _, err := in.businessLayer.Namespace.GetNamespace(namespace); err != nil {
+		return nil, err
+	}
+
+	var virtualServicesPerNamespace [][]kubernetes.IstioObject
+	var destinationRulesPerNamespace [][]kubernetes.IstioObject
+
+	wg := sync.WaitGroup{}
+	errChan := make(chan error, 1)
+
+	wg.Add(2)
+	go in.fetchVirtualServicesPerNamespace(&virtualServicesPerNamespace, errChan, &wg)
+	go in.fetchDestinationRulesPerNamespace(&destinationRulesPerNamespace, errChan, &wg)
+	wg.Wait()
+	close(errChan)
+	for e := range errChan {
+		if e != nil { // Check that default value wasn't returned
+			return nil, e
+		}
+	}
+
+	impactedVirtualServices := make([]kubernetes.IstioObject, 0)
+	for _, nsVirtualServices := range virtualServicesPerNamespace {
+		for _, virtualService := range nsVirtualServices {
+			vs := models.VirtualService{}
+			vs.Parse(virtualService)
+			for _, weight := range vs.Weights() {
+				if referencesServiceHost(weight.Host, virtualService.GetObjectMeta().Namespace, namespace, service) {
+					impactedVirtualServices = append(impactedVirtualServices, virtualService)
+					break
+				}
+			}
+		}
+	}
+
+	impactedDestinationRules := make([]kubernetes.IstioObject, 0)
+	for _, nsDestinationRules := range destinationRulesPerNamespace {
+		for _, destinationRule := range nsDestinationRules {
+			if host, ok := destinationRule.GetSpec()["host"].(string); ok && referencesServiceHost(host, destinationRule.GetObjectMeta().Namespace, namespace, service) {
+				impactedDestinationRules = append(impactedDestinationRules, destinationRule)
+			}
+		}
+	}
+
+	// Filtering without service name keeps every object, setting its type
+	impacted := kubernetes.FilterVirtualServices(impactedVirtualServices, namespace, "")
+	impacted = append(impacted, kubernetes.FilterDestinationRules(impactedDestinationRules, namespace, "")...)
+	return impacted, nil
+}
+
+// referencesServiceHost returns true when the host, set in an object of objectNamespace, refers to the service.
+// Single name hosts refer to the services of the object namespace only.
+func referencesServiceHost(host, objectNamespace, namespace, service string) bool {
+	if objectNamespace != namespace && host == service {
+		return false
+	}
+	return kubernetes.FilterByHost(host, service, namespace)
+}
+
+// GetVirtualServicesEffectiveness returns, per VirtualService name of the namespace, whether the VirtualService
+// is able to route traffic or is dead because of gateway, host or subset issues.
+func (in *IstioValidationsService) GetVirtualServicesEffectiveness(namespace string) (map[string]models.VirtualServiceEffectiveness, error) {
+	// Check if user has access to the namespace (RBAC) in cache scenarios and/or
+	// if namespace is accessible from Kiali (Deployment.AccessibleNamespaces)
+	if _, err := in.businessLayer.Namespace.GetNamespace(namespace); err != nil {
+		return nil, err
+	}
+
+	var istioDetails kubernetes.IstioDetails
+	var namespaces models.Namespaces
+	var gatewaysPerNamespace [][]kubernetes.IstioObject
+	var virtualServicesPerNamespace [][]kubernetes.IstioObject
+
+	wg := sync.WaitGroup{}
+	errChan := make(chan error, 1)
+
+	wg.Add(4)
+	go in.fetchDetails(&istioDetails, namespace, errChan, &wg)
+	go in.fetchNamespaces(&namespaces, errChan, &wg)
+	go in.fetchGatewaysPerNamespace(&gatewaysPerNamespace, errChan, &wg)
+	go in.fetchVirtualServicesPerNamespace(&virtualServicesPerNamespace, errChan, &wg)
+	wg.Wait()
+	close(errChan)
+	for e := range errChan {
+		if e != nil { // Check that default value wasn't returned
+			return nil, e
+		}
+	}
+
+	resolver := checkers.VirtualServiceEffectivenessResolver{
+		Namespace:            namespace,
+		Namespaces:           namespaces,
+		DestinationRules:     istioDetails.DestinationRules,
+		GatewaysPerNamespace: gatewaysPerNamespace,
+	}
+	for _, nsVirtualServices := range virtualServicesPerNamespace {
+		resolver.VirtualServices = append(resolver.VirtualServices, nsVirtualServices...)
+	}
+
+	effectiveness := make(map[string]models.VirtualServiceEffectiveness, len(istioDetails.VirtualServices))
+	for _, virtualService := range istioDetails.VirtualServices {
+		effectiveness[virtualService.GetObjectMeta().Name] = resolver.Resolve(virtualService)
+	}
+	return effectiveness, nil
+}
+
+// GetCrossNamespaceSubsetValidations returns the validations of the VirtualServices of the namespace routing to
+// subsets of hosts in other namespaces, and of the DestinationRules breaking those chains.
+func (in *IstioValidationsService) GetCrossNamespaceSubsetValidations(namespace string) (models.IstioValidations, error) {
+	// Check if user has access to the namespace (RBAC) in cache scenarios and/or
+	// if namespace is accessible from Kiali (Deployment.AccessibleNamespaces)
+	if _, err := in.businessLayer.Namespace.GetNamespace(namespace); err != nil {
+		return nil, err
+	}
+
+	var istioDetails kubernetes.IstioDetails
+	var namespaces models.Namespaces
+	var destinationRulesPerNamespace [][]kubernetes.IstioObject
+
+	wg := sync.WaitGroup{}
+	errChan := make(chan error, 1)
+
+	wg.Add(3)
+	go in.fetchDetails(&istioDetails, namespace, errChan, &wg)
+	go in.fetchNamespaces(&namespaces, errChan, &wg)
+	go in.fetchDestinationRulesPerNamespace(&destinationRulesPerNamespace, errChan, &wg)
+	wg.Wait()
+	close(errChan)
+	for e := range errChan {
+		if e != nil { // Check that default value wasn't returned
+			return nil, e
+		}
+	}
+
+	destinationRules := make([]kubernetes.IstioObject, 0)
+	for _, nsDestinationRules := range destinationRulesPerNamespace {
+		destinationRules = append(destinationRules, nsDestinationRules...)
+	}
+
+	return checkers.CrossNamespaceSubsetResolver{
+		Namespaces:       namespaces,
+		VirtualServices:  istioDetails.VirtualServices,
+		DestinationRules: destinationRules,
+	}.Resolve(), nil
+}
+
+func (in *IstioValidationsService) getValidations(namespace, service, proposedType string, proposed kubernetes.IstioObject) (models.IstioValidations, error) {
 	// Check if user has access to the namespace (RBAC) in cache scenarios and/or
 	// if namespace is accessible from Kiali (Deployment.AccessibleNamespaces)
 	if _, err := in.businessLayer.Namespace.GetNamespace(namespace); err != nil {
@@ -44,6 +222,17 @@ func (in *IstioValidationsService) GetValidations(namespace, service string) (mo
 		}
 	}
 
+	// Proposed objects have no resourceVersion yet, their validations are cached by the content hash of their spec
+	proposedKey := ""
+	if proposed != nil {
+		if hash := proposedContentHash(proposedType, proposed); hash != "" {
+			proposedKey = fmt.Sprintf("%s/%s/%s/%s", namespace, proposedType, proposed.GetObjectMeta().Name, hash)
+			if validations, found := getProposedValidations(proposedKey, time.Now()); found {
+				return validations, nil
+			}
+		}
+	}
+
 	wg := sync.WaitGroup{}
 	errChan := make(chan error, 1)
 
@@ -54,12 +243,15 @@ func (in *IstioValidationsService) GetValidations(namespace, service string) (mo
 	var workloads models.WorkloadList
 	var workloadsPerNamespace map[string]models.WorkloadList
 	var gatewaysPerNamespace [][]kubernetes.IstioObject
+	var virtualServicesPerNamespace [][]kubernetes.IstioObject
 	var mtlsDetails kubernetes.MTLSDetails
 	var rbacDetails kubernetes.RBACDetails
 	var deployments []apps_v1.Deployment
 	var registryStatus []*kubernetes.RegistryStatus
+	var clusterRegistryStatus map[string][]*kubernetes.RegistryStatus
+	var meshConfig *kubernetes.IstioMeshConfig
 
-	wg.Add(9) // We need to add these here to make sure we don't execute wg.Wait() before scheduler has started goroutines
+	wg.Add(11) // We need to add these here to make sure we don't execute wg.Wait() before scheduler has started goroutines
 
 	if service != "" {
 		// These resources are not used if no service is targeted
@@ -74,10 +266,12 @@ func (in *IstioValidationsService) GetValidations(namespace, service string) (mo
 	go in.fetchWorkloads(&workloads, namespace, errChan, &wg)
 	go in.fetchAllWorkloads(&workloadsPerNamespace, errChan, &wg)
 	go in.fetchGatewaysPerNamespace(&gatewaysPerNamespace, errChan, &wg)
+	go in.fetchVirtualServicesPerNamespace(&virtualServicesPerNamespace, errChan, &wg)
 	go in.fetchNonLocalmTLSConfigs(&mtlsDetails, namespace, errChan, &wg)
 	go in.fetchAuthorizationDetails(&rbacDetails, namespace, errChan, &wg)
 	go in.fetchServices(&services, namespace, errChan, &wg)
-	go in.fetchRegistryStatus(&registryStatus, errChan, &wg)
+	go in.fetchRegistryStatus(&registryStatus, &clusterRegistryStatus, errChan, &wg)
+	go in.fetchIstioMeshConfig(&meshConfig, &wg)
 
 	wg.Wait()
 	close(errChan)
@@ -86,11 +280,20 @@ func (in *IstioValidationsService) GetValidations(namespace, service string) (mo
 			return nil, e
 		}
 	}
+	applyIstioMeshConfig(meshConfig, &mtlsDetails, &rbacDetails)
+
+	if proposed != nil {
+		if err := addProposedObject(proposedType, proposed, &istioDetails, &mtlsDetails, &rbacDetails, &gatewaysPerNamespace, &virtualServicesPerNamespace); err != nil {
+			return nil, err
+		}
+	}
 
-	objectCheckers := in.getAllObjectCheckers(namespace, istioDetails, services, workloadsPerNamespace, workloads, gatewaysPerNamespace, mtlsDetails, rbacDetails, namespaces, registryStatus)
+	gatewaySecrets := in.fetchGatewaySecrets(istioDetails.Gateways, workloadsPerNamespace)
+
+	objectCheckers := in.getAllObjectCheckers(namespace, istioDetails, services, workloadsPerNamespace, workloads, gatewaysPerNamespace, virtualServicesPerNamespace, mtlsDetails, rbacDetails, namespaces, registryStatus, clusterRegistryStatus, gatewaySecrets)
 
 	if service != "" {
-		objectCheckers = append(objectCheckers, in.getServiceCheckers(namespace, services, deployments, pods)...)
+		objectCheckers = append(objectCheckers, in.getServiceCheckers(namespace, services, deployments, pods, istioDetails.DestinationRules, workloads)...)
 	}
 
 	// Get group validations for same kind istio objects
@@ -98,27 +301,172 @@ func (in *IstioValidationsService) GetValidations(namespace, service string) (mo
 	if service != "" {
 		validations = validations.FilterBySingleType("service", service)
 	}
+	if proposedKey != "" {
+		setProposedValidations(proposedKey, time.Now(), validations)
+	}
 
 	return validations, nil
 }
 
-func (in *IstioValidationsService) getServiceCheckers(namespace string, services []core_v1.Service, deployments []apps_v1.Deployment, pods []core_v1.Pod) []ObjectChecker {
+type timeValidations struct {
+	queryTime   time.Time
+	validations models.IstioValidations
+}
+
+// proposedValidationsCacheDuration keeps the cached validations of a proposed object short lived,
+// as they also depend on the state of the cluster around it
+const proposedValidationsCacheDuration = 10 * time.Second
+
+var (
+	// proposedValidationsCache holds the namespace validations computed with a proposed object,
+	// keyed by namespace, object type, name and content hash of the proposed object
+	proposedValidationsCache     = map[string]timeValidations{}
+	proposedValidationsCacheLock sync.RWMutex
+)
+
+func getProposedValidations(key string, queryTime time.Time) (models.IstioValidations, bool) {
+	defer proposedValidationsCacheLock.RUnlock()
+	proposedValidationsCacheLock.RLock()
+
+	if cached, found := proposedValidationsCache[key]; found && queryTime.Sub(cached.queryTime) < proposedValidationsCacheDuration {
+		return cached.validations, true
+	}
+	return nil, false
+}
+
+func setProposedValidations(key string, queryTime time.Time, validations models.IstioValidations) {
+	defer proposedValidationsCacheLock.Unlock()
+	proposedValidationsCacheLock.Lock()
+
+	// Expired entries are dropped here, so the cache only holds the proposals of the last few seconds
+	for k, cached := range proposedValidationsCache {
+		if queryTime.Sub(cached.queryTime) >= proposedValidationsCacheDuration {
+			delete(proposedValidationsCache, k)
+		}
+	}
+	proposedValidationsCache[key] = timeValidations{queryTime: queryTime, validations: validations}
+}
+
+// proposedContentHash returns the ContentHash of the proposed object, or "" when its type has no model to hash
+func proposedContentHash(objectType string, proposed kubernetes.IstioObject) string {
+	switch objectType {
+	case kubernetes.Gateways:
+		gw := models.Gateway{}
+		gw.Parse(proposed)
+		return gw.ContentHash()
+	case kubernetes.VirtualServices:
+		vs := models.VirtualService{}
+		vs.Parse(proposed)
+		return vs.ContentHash()
+	case kubernetes.DestinationRules:
+		dr := models.DestinationRule{}
+		dr.Parse(proposed)
+		return dr.ContentHash()
+	case kubernetes.ServiceEntries:
+		se := models.ServiceEntry{}
+		se.Parse(proposed)
+		return se.ContentHash()
+	case kubernetes.Sidecars:
+		sc := models.Sidecar{}
+		sc.Parse(proposed)
+		return sc.ContentHash()
+	case kubernetes.AuthorizationPolicies:
+		ap := models.AuthorizationPolicy{}
+		ap.Parse(proposed)
+		return ap.ContentHash()
+	case kubernetes.PeerAuthentications:
+		pa := models.PeerAuthentication{}
+		pa.Parse(proposed)
+		return pa.ContentHash()
+	case kubernetes.RequestAuthentications:
+		ra := models.RequestAuthentication{}
+		ra.Parse(proposed)
+		return ra.ContentHash()
+	case kubernetes.EnvoyFilters:
+		ef := models.EnvoyFilter{}
+		ef.Parse(proposed)
+		return ef.ContentHash()
+	case kubernetes.WorkloadEntries:
+		we := models.WorkloadEntry{}
+		we.Parse(proposed)
+		return we.ContentHash()
+	}
+	return ""
+}
+
+// addProposedObject places the proposed object into the fetched details used by the checkers
+func addProposedObject(objectType string, proposed kubernetes.IstioObject, istioDetails *kubernetes.IstioDetails, mtlsDetails *kubernetes.MTLSDetails, rbacDetails *kubernetes.RBACDetails, gatewaysPerNamespace, virtualServicesPerNamespace *[][]kubernetes.IstioObject) error {
+	switch objectType {
+	case kubernetes.Gateways:
+		istioDetails.Gateways = replaceIstioObject(istioDetails.Gateways, proposed)
+		for i, nsGateways := range *gatewaysPerNamespace {
+			(*gatewaysPerNamespace)[i] = removeIstioObject(nsGateways, proposed)
+		}
+		*gatewaysPerNamespace = append(*gatewaysPerNamespace, []kubernetes.IstioObject{proposed})
+	case kubernetes.VirtualServices:
+		istioDetails.VirtualServices = replaceIstioObject(istioDetails.VirtualServices, proposed)
+		for i, nsVirtualServices := range *virtualServicesPerNamespace {
+			(*virtualServicesPerNamespace)[i] = removeIstioObject(nsVirtualServices, proposed)
+		}
+		*virtualServicesPerNamespace = append(*virtualServicesPerNamespace, []kubernetes.IstioObject{proposed})
+	case kubernetes.DestinationRules:
+		istioDetails.DestinationRules = replaceIstioObject(istioDetails.DestinationRules, proposed)
+		mtlsDetails.DestinationRules = replaceIstioObject(mtlsDetails.DestinationRules, proposed)
+	case kubernetes.ServiceEntries:
+		istioDetails.ServiceEntries = replaceIstioObject(istioDetails.ServiceEntries, proposed)
+	case kubernetes.Sidecars:
+		istioDetails.Sidecars = replaceIstioObject(istioDetails.Sidecars, proposed)
+	case kubernetes.AuthorizationPolicies:
+		rbacDetails.AuthorizationPolicies = replaceIstioObject(rbacDetails.AuthorizationPolicies, proposed)
+	case kubernetes.PeerAuthentications:
+		mtlsDetails.PeerAuthentications = replaceIstioObject(mtlsDetails.PeerAuthentications, proposed)
+	case kubernetes.RequestAuthentications:
+		istioDetails.RequestAuthentications = replaceIstioObject(istioDetails.RequestAuthentications, proposed)
+	case kubernetes.EnvoyFilters:
+		istioDetails.EnvoyFilters = replaceIstioObject(istioDetails.EnvoyFilters, proposed)
+	case kubernetes.WorkloadEntries:
+		istioDetails.WorkloadEntries = replaceIstioObject(istioDetails.WorkloadEntries, proposed)
+	default:
+		return fmt.Errorf("Object type not supported for validations delta: %s", objectType)
+	}
+	return nil
+}
+
+func replaceIstioObject(objects []kubernetes.IstioObject, proposed kubernetes.IstioObject) []kubernetes.IstioObject {
+	return append(removeIstioObject(objects, proposed), proposed)
+}
+
+func removeIstioObject(objects []kubernetes.IstioObject, proposed kubernetes.IstioObject) []kubernetes.IstioObject {
+	filtered := make([]kubernetes.IstioObject, 0, len(objects))
+	for _, object := range objects {
+		if object.GetObjectMeta().Name == proposed.GetObjectMeta().Name && object.GetObjectMeta().Namespace == proposed.GetObjectMeta().Namespace {
+			continue
+		}
+		filtered = append(filtered, object)
+	}
+	return filtered
+}
+
+func (in *IstioValidationsService) getServiceCheckers(namespace string, services []core_v1.Service, deployments []apps_v1.Deployment, pods []core_v1.Pod, destinationRules []kubernetes.IstioObject, workloads models.WorkloadList) []ObjectChecker {
 	return []ObjectChecker{
-		checkers.ServiceChecker{Services: services, Deployments: deployments, Pods: pods},
+		checkers.ServiceChecker{Services: services, Deployments: deployments, Pods: pods, DestinationRules: destinationRules, WorkloadList: workloads},
 	}
 }
 
-func (in *IstioValidationsService) getAllObjectCheckers(namespace string, istioDetails kubernetes.IstioDetails, services []core_v1.Service, workloadsPerNamespace map[string]models.WorkloadList, workloads models.WorkloadList, gatewaysPerNamespace [][]kubernetes.IstioObject, mtlsDetails kubernetes.MTLSDetails, rbacDetails kubernetes.RBACDetails, namespaces []models.Namespace, registryStatus []*kubernetes.RegistryStatus) []ObjectChecker {
+func (in *IstioValidationsService) getAllObjectCheckers(namespace string, istioDetails kubernetes.IstioDetails, services []core_v1.Service, workloadsPerNamespace map[string]models.WorkloadList, workloads models.WorkloadList, gatewaysPerNamespace, virtualServicesPerNamespace [][]kubernetes.IstioObject, mtlsDetails kubernetes.MTLSDetails, rbacDetails kubernetes.RBACDetails, namespaces []models.Namespace, registryStatus []*kubernetes.RegistryStatus, clusterRegistryStatus map[string][]*kubernetes.RegistryStatus, gatewaySecrets map[string][]core_v1.Secret) []ObjectChecker {
 	return []ObjectChecker{
-		checkers.NoServiceChecker{Namespace: namespace, Namespaces: namespaces, IstioDetails: &istioDetails, Services: services, WorkloadList: workloads, GatewaysPerNamespace: gatewaysPerNamespace, AuthorizationDetails: &rbacDetails, RegistryStatus: registryStatus},
-		checkers.VirtualServiceChecker{Namespace: namespace, Namespaces: namespaces, DestinationRules: istioDetails.DestinationRules, VirtualServices: istioDetails.VirtualServices},
-		checkers.DestinationRulesChecker{Namespaces: namespaces, DestinationRules: istioDetails.DestinationRules, MTLSDetails: mtlsDetails, ServiceEntries: istioDetails.ServiceEntries},
-		checkers.GatewayChecker{GatewaysPerNamespace: gatewaysPerNamespace, Namespace: namespace, WorkloadsPerNamespace: workloadsPerNamespace},
-		checkers.PeerAuthenticationChecker{PeerAuthentications: mtlsDetails.PeerAuthentications, MTLSDetails: mtlsDetails, WorkloadList: workloads},
-		checkers.ServiceEntryChecker{ServiceEntries: istioDetails.ServiceEntries, Namespaces: namespaces},
-		checkers.AuthorizationPolicyChecker{AuthorizationPolicies: rbacDetails.AuthorizationPolicies, Namespace: namespace, Namespaces: namespaces, Services: services, ServiceEntries: istioDetails.ServiceEntries, WorkloadList: workloads, MtlsDetails: mtlsDetails, VirtualServices: istioDetails.VirtualServices, RegistryStatus: registryStatus},
+		checkers.NoServiceChecker{Namespace: namespace, Namespaces: namespaces, IstioDetails: &istioDetails, Services: services, WorkloadList: workloads, GatewaysPerNamespace: gatewaysPerNamespace, AuthorizationDetails: &rbacDetails, RegistryStatus: registryStatus, ClusterRegistryStatus: clusterRegistryStatus},
+		checkers.VirtualServiceChecker{Namespace: namespace, Namespaces: namespaces, DestinationRules: istioDetails.DestinationRules, VirtualServices: istioDetails.VirtualServices, Services: services, VirtualServicesPerNamespace: virtualServicesPerNamespace, WorkloadList: workloads},
+		checkers.DestinationRulesChecker{Namespaces: namespaces, DestinationRules: istioDetails.DestinationRules, MTLSDetails: mtlsDetails, ServiceEntries: istioDetails.ServiceEntries, Services: services, WorkloadList: workloads},
+		checkers.GatewayChecker{GatewaysPerNamespace: gatewaysPerNamespace, VirtualServicesPerNamespace: virtualServicesPerNamespace, Namespace: namespace, Namespaces: namespaces, WorkloadsPerNamespace: workloadsPerNamespace, SecretsPerNamespace: gatewaySecrets},
+		checkers.PeerAuthenticationChecker{PeerAuthentications: mtlsDetails.PeerAuthentications, MTLSDetails: mtlsDetails, WorkloadList: workloads, Services: services},
+		checkers.ServiceEntryChecker{ServiceEntries: istioDetails.ServiceEntries, DestinationRules: istioDetails.DestinationRules, Namespaces: namespaces, Services: services, RegistryStatus: registryStatus},
+		checkers.AuthorizationPolicyChecker{AuthorizationPolicies: rbacDetails.AuthorizationPolicies, Namespace: namespace, Namespaces: namespaces, Services: services, ServiceEntries: istioDetails.ServiceEntries, WorkloadList: workloads, MtlsDetails: mtlsDetails, VirtualServices: istioDetails.VirtualServices, RegistryStatus: registryStatus, ExtensionProviders: rbacDetails.ExtensionProviders, ServiceAccounts: serviceAccountsPerNamespace(workloadsPerNamespace)},
 		checkers.SidecarChecker{Sidecars: istioDetails.Sidecars, Namespaces: namespaces, WorkloadList: workloads, Services: services, ServiceEntries: istioDetails.ServiceEntries},
 		checkers.RequestAuthenticationChecker{RequestAuthentications: istioDetails.RequestAuthentications, WorkloadList: workloads},
+		checkers.EnvoyFilterChecker{EnvoyFilters: istioDetails.EnvoyFilters},
+		checkers.WorkloadEntryChecker{WorkloadEntries: istioDetails.WorkloadEntries, DestinationRules: istioDetails.DestinationRules, Namespaces: namespaces, Services: services},
+		checkers.TelemetryChecker{Telemetries: istioDetails.Telemetries, ExtensionProviders: rbacDetails.ExtensionProviders},
 	}
 }
 
@@ -129,9 +477,12 @@ func (in *IstioValidationsService) GetIstioObjectValidations(namespace string, o
 	var workloads models.WorkloadList
 	var workloadsPerNamespace map[string]models.WorkloadList
 	var gatewaysPerNamespace [][]kubernetes.IstioObject
+	var virtualServicesPerNamespace [][]kubernetes.IstioObject
 	var mtlsDetails kubernetes.MTLSDetails
 	var rbacDetails kubernetes.RBACDetails
 	var registryStatus []*kubernetes.RegistryStatus
+	var clusterRegistryStatus map[string][]*kubernetes.RegistryStatus
+	var meshConfig *kubernetes.IstioMeshConfig
 	var err error
 	var objectCheckers []ObjectChecker
 
@@ -144,34 +495,38 @@ func (in *IstioValidationsService) GetIstioObjectValidations(namespace string, o
 	wg := sync.WaitGroup{}
 	errChan := make(chan error, 1)
 
-	// Get all the Istio objects from a Namespace and all gateways from every namespace
-	wg.Add(9)
+	// Get all the Istio objects from a Namespace and all gateways and virtual services from every namespace
+	wg.Add(11)
 	go in.fetchNamespaces(&namespaces, errChan, &wg)
 	go in.fetchDetails(&istioDetails, namespace, errChan, &wg)
 	go in.fetchServices(&services, namespace, errChan, &wg)
 	go in.fetchWorkloads(&workloads, namespace, errChan, &wg)
 	go in.fetchAllWorkloads(&workloadsPerNamespace, errChan, &wg)
 	go in.fetchGatewaysPerNamespace(&gatewaysPerNamespace, errChan, &wg)
+	go in.fetchVirtualServicesPerNamespace(&virtualServicesPerNamespace, errChan, &wg)
 	go in.fetchNonLocalmTLSConfigs(&mtlsDetails, namespace, errChan, &wg)
 	go in.fetchAuthorizationDetails(&rbacDetails, namespace, errChan, &wg)
-	go in.fetchRegistryStatus(&registryStatus, errChan, &wg)
+	go in.fetchRegistryStatus(&registryStatus, &clusterRegistryStatus, errChan, &wg)
+	go in.fetchIstioMeshConfig(&meshConfig, &wg)
 	wg.Wait()
+	applyIstioMeshConfig(meshConfig, &mtlsDetails, &rbacDetails)
 
-	noServiceChecker := checkers.NoServiceChecker{Namespace: namespace, Namespaces: namespaces, IstioDetails: &istioDetails, Services: services, WorkloadList: workloads, GatewaysPerNamespace: gatewaysPerNamespace, AuthorizationDetails: &rbacDetails, RegistryStatus: registryStatus}
+	noServiceChecker := checkers.NoServiceChecker{Namespace: namespace, Namespaces: namespaces, IstioDetails: &istioDetails, Services: services, WorkloadList: workloads, GatewaysPerNamespace: gatewaysPerNamespace, AuthorizationDetails: &rbacDetails, RegistryStatus: registryStatus, ClusterRegistryStatus: clusterRegistryStatus}
 
 	switch objectType {
 	case kubernetes.Gateways:
+		gatewaySecrets := in.fetchGatewaySecrets(istioDetails.Gateways, workloadsPerNamespace)
 		objectCheckers = []ObjectChecker{
-			checkers.GatewayChecker{GatewaysPerNamespace: gatewaysPerNamespace, Namespace: namespace, WorkloadsPerNamespace: workloadsPerNamespace},
+			checkers.GatewayChecker{GatewaysPerNamespace: gatewaysPerNamespace, VirtualServicesPerNamespace: virtualServicesPerNamespace, Namespace: namespace, Namespaces: namespaces, WorkloadsPerNamespace: workloadsPerNamespace, SecretsPerNamespace: gatewaySecrets},
 		}
 	case kubernetes.VirtualServices:
-		virtualServiceChecker := checkers.VirtualServiceChecker{Namespace: namespace, Namespaces: namespaces, VirtualServices: istioDetails.VirtualServices, DestinationRules: istioDetails.DestinationRules}
+		virtualServiceChecker := checkers.VirtualServiceChecker{Namespace: namespace, Namespaces: namespaces, VirtualServices: istioDetails.VirtualServices, DestinationRules: istioDetails.DestinationRules, Services: services, VirtualServicesPerNamespace: virtualServicesPerNamespace, WorkloadList: workloads}
 		objectCheckers = []ObjectChecker{noServiceChecker, virtualServiceChecker}
 	case kubernetes.DestinationRules:
-		destinationRulesChecker := checkers.DestinationRulesChecker{Namespaces: namespaces, DestinationRules: istioDetails.DestinationRules, MTLSDetails: mtlsDetails, ServiceEntries: istioDetails.ServiceEntries}
+		destinationRulesChecker := checkers.DestinationRulesChecker{Namespaces: namespaces, DestinationRules: istioDetails.DestinationRules, MTLSDetails: mtlsDetails, ServiceEntries: istioDetails.ServiceEntries, Services: services, WorkloadList: workloads}
 		objectCheckers = []ObjectChecker{noServiceChecker, destinationRulesChecker}
 	case kubernetes.ServiceEntries:
-		serviceEntryChecker := checkers.ServiceEntryChecker{ServiceEntries: istioDetails.ServiceEntries, Namespaces: namespaces}
+		serviceEntryChecker := checkers.ServiceEntryChecker{ServiceEntries: istioDetails.ServiceEntries, DestinationRules: istioDetails.DestinationRules, Namespaces: namespaces, Services: services, RegistryStatus: registryStatus}
 		objectCheckers = []ObjectChecker{serviceEntryChecker}
 	case kubernetes.Sidecars:
 		sidecarsChecker := checkers.SidecarChecker{Sidecars: istioDetails.Sidecars, Namespaces: namespaces,
@@ -180,14 +535,16 @@ func (in *IstioValidationsService) GetIstioObjectValidations(namespace string, o
 	case kubernetes.AuthorizationPolicies:
 		authPoliciesChecker := checkers.AuthorizationPolicyChecker{AuthorizationPolicies: rbacDetails.AuthorizationPolicies,
 			Namespace: namespace, Namespaces: namespaces, Services: services, ServiceEntries: istioDetails.ServiceEntries,
-			WorkloadList: workloads, MtlsDetails: mtlsDetails, VirtualServices: istioDetails.VirtualServices}
+			WorkloadList: workloads, MtlsDetails: mtlsDetails, VirtualServices: istioDetails.VirtualServices, ExtensionProviders: rbacDetails.ExtensionProviders,
+			ServiceAccounts: serviceAccountsPerNamespace(workloadsPerNamespace)}
 		objectCheckers = []ObjectChecker{authPoliciesChecker}
 	case kubernetes.PeerAuthentications:
 		// Validations on PeerAuthentications
-		peerAuthnChecker := checkers.PeerAuthenticationChecker{PeerAuthentications: mtlsDetails.PeerAuthentications, MTLSDetails: mtlsDetails, WorkloadList: workloads}
+		peerAuthnChecker := checkers.PeerAuthenticationChecker{PeerAuthentications: mtlsDetails.PeerAuthentications, MTLSDetails: mtlsDetails, WorkloadList: workloads, Services: services}
 		objectCheckers = []ObjectChecker{peerAuthnChecker}
 	case kubernetes.WorkloadEntries:
-		// Validation on WorkloadEntries are not yet in place
+		workloadEntryChecker := checkers.WorkloadEntryChecker{WorkloadEntries: istioDetails.WorkloadEntries, DestinationRules: istioDetails.DestinationRules, Namespaces: namespaces, Services: services}
+		objectCheckers = []ObjectChecker{workloadEntryChecker}
 	case kubernetes.WorkloadGroups:
 		// Validation on WorkloadGroups are not yet in place
 	case kubernetes.RequestAuthentications:
@@ -195,7 +552,11 @@ func (in *IstioValidationsService) GetIstioObjectValidations(namespace string, o
 		requestAuthnChecker := checkers.RequestAuthenticationChecker{RequestAuthentications: istioDetails.RequestAuthentications, WorkloadList: workloads}
 		objectCheckers = []ObjectChecker{requestAuthnChecker}
 	case kubernetes.EnvoyFilters:
-		// Validation on EnvoyFilters are not yet in place
+		envoyFilterChecker := checkers.EnvoyFilterChecker{EnvoyFilters: istioDetails.EnvoyFilters}
+		objectCheckers = []ObjectChecker{envoyFilterChecker}
+	case kubernetes.Telemetries:
+		telemetryChecker := checkers.TelemetryChecker{Telemetries: istioDetails.Telemetries, ExtensionProviders: rbacDetails.ExtensionProviders}
+		objectCheckers = []ObjectChecker{telemetryChecker}
 	default:
 		err = fmt.Errorf("object type not found: %v", objectType)
 	}
@@ -214,6 +575,107 @@ func (in *IstioValidationsService) GetIstioObjectValidations(namespace string, o
 	return runObjectCheckers(objectCheckers).FilterByKey(models.ObjectTypeSingular[objectType], object), nil
 }
 
+// securityCheckPrefixes are the check key prefixes of the security checks reported by other object types.
+// All the checks of the securityObjectTypes belong to the security category.
+var securityCheckPrefixes = []string{
+	"authorizationpolicy",
+	"destinationrules.mtls",
+	"destinationrules.trafficpolicy.notlssettings",
+	"gateways.tls",
+	"peerauthentication",
+	"servicerole",
+}
+
+var securityObjectTypes = map[string]bool{
+	checkers.AuthorizationPolicyCheckerType:   true,
+	checkers.PeerAuthenticationCheckerType:    true,
+	checkers.RequestAuthenticationCheckerType: true,
+	checkers.ServiceRoleCheckerType:           true,
+	"servicerolebinding":                      true,
+}
+
+// GetValidationsByCategory returns the validations of the namespace keeping only the checks of the given category.
+// Objects without checks of that category are left out, unless all their checks belong to it.
+func (in *IstioValidationsService) GetValidationsByCategory(namespace string, category models.ValidationCategory) (models.IstioValidations, error) {
+	validations, err := in.GetValidations(namespace, "")
+	if err != nil {
+		return nil, err
+	}
+	return filterValidationsByCategory(validations, category), nil
+}
+
+// GetValidationMessageKeys returns the distinct message keys of the checks currently reported
+// for the objects of the namespace, with the number of checks reporting each of them.
+func (in *IstioValidationsService) GetValidationMessageKeys(namespace string) (map[string]int, error) {
+	validations, err := in.GetValidations(namespace, "")
+	if err != nil {
+		return nil, err
+	}
+	return validations.SummarizeMessageKeys(namespace), nil
+}
+
+func filterValidationsByCategory(validations models.IstioValidations, category models.ValidationCategory) models.IstioValidations {
+	filtered := models.IstioValidations{}
+	for key, validation := range validations {
+		objectCategory := models.TrafficValidationCategory
+		if securityObjectTypes[key.ObjectType] {
+			objectCategory = models.SecurityValidationCategory
+		}
+
+		checks := make([]*models.IstioCheck, 0, len(validation.Checks))
+		valid := true
+		for _, check := range validation.Checks {
+			checkCategory := objectCategory
+			if objectCategory != models.SecurityValidationCategory {
+				key, _ := models.CheckKey(check.Code)
+				checkCategory = validationRuleCategory(key)
+			}
+			if checkCategory == category {
+				checks = append(checks, check)
+				valid = valid && check.Severity != models.ErrorSeverity
+			}
+		}
+
+		if objectCategory == category || len(checks) > 0 {
+			categoryValidation := *validation
+			categoryValidation.Checks = checks
+			categoryValidation.Valid = valid
+			filtered[key] = &categoryValidation
+		}
+	}
+	return filtered
+}
+
+// GetValidationRules returns the catalog of checks that validations can report, sorted by key,
+// with their default severity and the object types reporting them.
+func (in *IstioValidationsService) GetValidationRules() []models.ValidationRule {
+	descriptors := models.CheckDescriptors()
+	rules := make([]models.ValidationRule, 0, len(descriptors))
+	for key, check := range descriptors {
+		rules = append(rules, models.ValidationRule{
+			Key:         key,
+			Code:        check.Code,
+			Message:     check.Message,
+			Severity:    check.Severity,
+			ObjectTypes: models.CheckObjectTypes(key),
+			Category:    validationRuleCategory(key),
+		})
+	}
+	sort.Slice(rules, func(i, j int) bool {
+		return rules[i].Key < rules[j].Key
+	})
+	return rules
+}
+
+func validationRuleCategory(key string) models.ValidationCategory {
+	for _, prefix := range securityCheckPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return models.SecurityValidationCategory
+		}
+	}
+	return models.TrafficValidationCategory
+}
+
 func runObjectCheckers(objectCheckers []ObjectChecker) models.IstioValidations {
 	objectTypeValidations := models.IstioValidations{}
 
@@ -231,28 +693,40 @@ func runObjectCheckers(objectCheckers []ObjectChecker) models.IstioValidations {
 // write to the buffered errChan, we just ignore the error as select does not block even if channel is full. This is because a single error is enough to cancel the whole request.
 
 func (in *IstioValidationsService) fetchGatewaysPerNamespace(gatewaysPerNamespace *[][]kubernetes.IstioObject, errChan chan error, wg *sync.WaitGroup) {
+	in.fetchIstioObjectsPerNamespace(gatewaysPerNamespace, kubernetes.Gateways, errChan, wg)
+}
+
+func (in *IstioValidationsService) fetchDestinationRulesPerNamespace(destinationRulesPerNamespace *[][]kubernetes.IstioObject, errChan chan error, wg *sync.WaitGroup) {
+	in.fetchIstioObjectsPerNamespace(destinationRulesPerNamespace, kubernetes.DestinationRules, errChan, wg)
+}
+
+func (in *IstioValidationsService) fetchVirtualServicesPerNamespace(virtualServicesPerNamespace *[][]kubernetes.IstioObject, errChan chan error, wg *sync.WaitGroup) {
+	in.fetchIstioObjectsPerNamespace(virtualServicesPerNamespace, kubernetes.VirtualServices, errChan, wg)
+}
+
+func (in *IstioValidationsService) fetchIstioObjectsPerNamespace(objectsPerNamespace *[][]kubernetes.IstioObject, resourceType string, errChan chan error, wg *sync.WaitGroup) {
 	defer wg.Done()
 	if nss, err := in.businessLayer.Namespace.GetNamespaces(); err == nil {
-		gwss := make([][]kubernetes.IstioObject, len(nss))
+		objss := make([][]kubernetes.IstioObject, len(nss))
 		for i := range nss {
-			gwss[i] = make([]kubernetes.IstioObject, 0)
+			objss[i] = make([]kubernetes.IstioObject, 0)
 		}
-		*gatewaysPerNamespace = gwss
+		*objectsPerNamespace = objss
 
 		wg.Add(len(nss))
 		for i, ns := range nss {
-			var getCacheGateways func(string) ([]kubernetes.IstioObject, error)
+			var getCacheObjects func(string) ([]kubernetes.IstioObject, error)
 			// businessLayer.Namespace.GetNamespaces() is invoked before, so, namespace used are under the user's view
-			if IsResourceCached(ns.Name, kubernetes.Gateways) {
-				getCacheGateways = func(namespace string) ([]kubernetes.IstioObject, error) {
-					return kialiCache.GetIstioObjects(namespace, kubernetes.Gateways, "")
+			if IsResourceCached(ns.Name, resourceType) {
+				getCacheObjects = func(namespace string) ([]kubernetes.IstioObject, error) {
+					return kialiCache.GetIstioObjects(namespace, resourceType, "")
 				}
 			} else {
-				getCacheGateways = func(namespace string) ([]kubernetes.IstioObject, error) {
-					return in.k8s.GetIstioObjects(namespace, kubernetes.Gateways, "")
+				getCacheObjects = func(namespace string) ([]kubernetes.IstioObject, error) {
+					return in.k8s.GetIstioObjects(namespace, resourceType, "")
 				}
 			}
-			go fetchIstioObjects(&gwss[i], ns.Name, getCacheGateways, wg, errChan)
+			go fetchIstioObjects(&objss[i], ns.Name, getCacheObjects, wg, errChan)
 		}
 	} else {
 		select {
@@ -464,6 +938,33 @@ func (in *IstioValidationsService) fetchDetails(rValue *kubernetes.IstioDetails,
 			}
 			go fetchIstioObjects(&istioDetails.RequestAuthentications, namespace, getRequestAuthentications, &wg2, errChan2)
 		}
+		if IsResourceCached(namespace, kubernetes.EnvoyFilters) {
+			istioDetails.EnvoyFilters, err = kialiCache.GetIstioObjects(namespace, kubernetes.EnvoyFilters, "")
+		} else {
+			wg2.Add(1)
+			getEnvoyFilters := func(namespace string) ([]kubernetes.IstioObject, error) {
+				return in.k8s.GetIstioObjects(namespace, kubernetes.EnvoyFilters, "")
+			}
+			go fetchIstioObjects(&istioDetails.EnvoyFilters, namespace, getEnvoyFilters, &wg2, errChan2)
+		}
+		if IsResourceCached(namespace, kubernetes.WorkloadEntries) {
+			istioDetails.WorkloadEntries, err = kialiCache.GetIstioObjects(namespace, kubernetes.WorkloadEntries, "")
+		} else {
+			wg2.Add(1)
+			getWorkloadEntries := func(namespace string) ([]kubernetes.IstioObject, error) {
+				return in.k8s.GetIstioObjects(namespace, kubernetes.WorkloadEntries, "")
+			}
+			go fetchIstioObjects(&istioDetails.WorkloadEntries, namespace, getWorkloadEntries, &wg2, errChan2)
+		}
+		if IsResourceCached(namespace, kubernetes.Telemetries) {
+			istioDetails.Telemetries, err = kialiCache.GetIstioObjects(namespace, kubernetes.Telemetries, "")
+		} else {
+			wg2.Add(1)
+			getTelemetries := func(namespace string) ([]kubernetes.IstioObject, error) {
+				return in.k8s.GetIstioObjects(namespace, kubernetes.Telemetries, "")
+			}
+			go fetchIstioObjects(&istioDetails.Telemetries, namespace, getTelemetries, &wg2, errChan2)
+		}
 		wg2.Wait()
 
 		// Error may come either from errChan2 (when goroutines are used / without cache) or err (with cache / synchronous)
@@ -488,7 +989,7 @@ func (in *IstioValidationsService) fetchNonLocalmTLSConfigs(mtlsDetails *kuberne
 		return
 	}
 
-	wg.Add(3)
+	wg.Add(2)
 
 	go func(details *kubernetes.MTLSDetails) {
 		defer wg.Done()
@@ -525,29 +1026,6 @@ func (in *IstioValidationsService) fetchNonLocalmTLSConfigs(mtlsDetails *kuberne
 		}
 	}(mtlsDetails)
 
-	go func(details *kubernetes.MTLSDetails) {
-		defer wg.Done()
-		cfg := config.Get()
-
-		var istioConfig *core_v1.ConfigMap
-		var err error
-		if IsNamespaceCached(cfg.IstioNamespace) {
-			istioConfig, err = kialiCache.GetConfigMap(cfg.IstioNamespace, cfg.ExternalServices.Istio.ConfigMapName)
-		} else {
-			istioConfig, err = in.k8s.GetConfigMap(cfg.IstioNamespace, cfg.ExternalServices.Istio.ConfigMapName)
-		}
-		if err != nil {
-			errChan <- err
-			return
-		}
-		icm, err := kubernetes.GetIstioConfigMap(istioConfig)
-		if err != nil {
-			errChan <- err
-		} else {
-			details.EnabledAutoMtls = icm.GetEnableAutoMtls()
-		}
-	}(mtlsDetails)
-
 	namespaces, err := in.businessLayer.Namespace.GetNamespaces()
 	if err != nil {
 		errChan <- err
@@ -614,7 +1092,45 @@ func (in *IstioValidationsService) fetchAuthorizationDetails(rValue *kubernetes.
 	}
 }
 
-func (in *IstioValidationsService) fetchRegistryStatus(rValue *[]*kubernetes.RegistryStatus, errChan chan error, wg *sync.WaitGroup) {
+// fetchIstioMeshConfig reads the mesh config from the istio ConfigMap. A failure doesn't stop the validations:
+// it is logged and rValue is left nil, so the settings read from it remain unknown.
+func (in *IstioValidationsService) fetchIstioMeshConfig(rValue **kubernetes.IstioMeshConfig, wg *sync.WaitGroup) {
+	defer wg.Done()
+	cfg := config.Get()
+
+	var istioConfig *core_v1.ConfigMap
+	var err error
+	if IsNamespaceCached(cfg.IstioNamespace) {
+		istioConfig, err = kialiCache.GetConfigMap(cfg.IstioNamespace, cfg.ExternalServices.Istio.ConfigMapName)
+	} else {
+		istioConfig, err = in.k8s.GetConfigMap(cfg.IstioNamespace, cfg.ExternalServices.Istio.ConfigMapName)
+	}
+	if err != nil {
+		if !checkForbidden("fetchIstioMeshConfig", err, "") {
+			log.Warningf("Error reading the istio ConfigMap: %s", err)
+		}
+		return
+	}
+	icm, err := kubernetes.GetIstioConfigMap(istioConfig)
+	if err != nil {
+		log.Warningf("Error parsing the istio ConfigMap: %s", err)
+		return
+	}
+	*rValue = icm
+}
+
+// applyIstioMeshConfig sets the details read from the mesh config. When it couldn't be read, auto mTLS is
+// assumed enabled, as Istio does by default, and the extension providers are left unknown.
+func applyIstioMeshConfig(meshConfig *kubernetes.IstioMeshConfig, mtlsDetails *kubernetes.MTLSDetails, rbacDetails *kubernetes.RBACDetails) {
+	if meshConfig == nil {
+		mtlsDetails.EnabledAutoMtls = true
+		return
+	}
+	mtlsDetails.EnabledAutoMtls = meshConfig.GetEnableAutoMtls()
+	rbacDetails.ExtensionProviders = meshConfig.GetExtensionProviderNames()
+}
+
+func (in *IstioValidationsService) fetchRegistryStatus(rValue *[]*kubernetes.RegistryStatus, rClusterValue *map[string][]*kubernetes.RegistryStatus, errChan chan error, wg *sync.WaitGroup) {
 	defer wg.Done()
 	registryStatus, err := in.businessLayer.RegistryStatus.GetRegistryStatus()
 	if err != nil {
@@ -624,7 +1140,55 @@ func (in *IstioValidationsService) fetchRegistryStatus(rValue *[]*kubernetes.Reg
 		}
 	} else {
 		*rValue = registryStatus
+		*rClusterValue = registryStatusPerCluster(registryStatus)
+	}
+}
+
+// fetchGatewaySecrets returns the secrets of the namespaces running the workloads of the Gateways referencing a credentialName.
+// Namespaces whose secrets can't be read, i.e. when Kiali isn't allowed to list them, are left out so their credentials aren't validated.
+func (in *IstioValidationsService) fetchGatewaySecrets(gws []kubernetes.IstioObject, workloadsPerNamespace map[string]models.WorkloadList) map[string][]core_v1.Secret {
+	secretsPerNamespace := make(map[string][]core_v1.Secret)
+	for _, gw := range gws {
+		for _, namespace := range gateways.CredentialSecretNamespaces(gw, workloadsPerNamespace) {
+			if _, fetched := secretsPerNamespace[namespace]; fetched {
+				continue
+			}
+			secrets, err := in.k8s.GetSecrets(namespace, "")
+			if err != nil {
+				if !checkForbidden("fetchGatewaySecrets", err, namespace) {
+					log.Warningf("Error reading the secrets of namespace %s: %s", namespace, err)
+				}
+				continue
+			}
+			secretsPerNamespace[namespace] = secrets
+		}
+	}
+	return secretsPerNamespace
+}
+
+// serviceAccountsPerNamespace returns the service accounts used by the workload pods of each namespace.
+// Namespaces without running pods are left out, as their service accounts can't be known.
+func serviceAccountsPerNamespace(workloadsPerNamespace map[string]models.WorkloadList) map[string][]string {
+	serviceAccounts := make(map[string][]string, len(workloadsPerNamespace))
+	for namespace, workloadList := range workloadsPerNamespace {
+		for _, wl := range workloadList.Workloads {
+			if len(wl.ServiceAccountNames) > 0 {
+				serviceAccounts[namespace] = append(serviceAccounts[namespace], wl.ServiceAccountNames...)
+			}
+		}
+	}
+	return serviceAccounts
+}
+
+// registryStatusPerCluster groups the registry services by the clusters they have a VIP in
+func registryStatusPerCluster(registryStatus []*kubernetes.RegistryStatus) map[string][]*kubernetes.RegistryStatus {
+	clusterRegistryStatus := make(map[string][]*kubernetes.RegistryStatus)
+	for _, rs := range registryStatus {
+		for cluster := range rs.ClusterVIPs {
+			clusterRegistryStatus[cluster] = append(clusterRegistryStatus[cluster], rs)
+		}
 	}
+	return clusterRegistryStatus
 }
 
 var (
@@ -2,6 +2,7 @@ package business
 
 import (
 	"testing"
+	"time"
 
 	osapps_v1 "github.com/openshift/api/apps/v1"
 	"github.com/stretchr/testify/assert"
@@ -10,8 +11,10 @@ import (
 	batch_v1 "k8s.io/api/batch/v1"
 	batch_v1beta1 "k8s.io/api/batch/v1beta1"
 	core_v1 "k8s.io/api/core/v1"
+	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/kiali/kiali/business/checkers"
 	"github.com/kiali/kiali/config"
 	"github.com/kiali/kiali/kubernetes"
 	"github.com/kiali/kiali/kubernetes/kubetest"
@@ -54,6 +57,370 @@ func TestGatewayValidation(t *testing.T) {
 	assert.NotEmpty(validations)
 }
 
+func TestGetValidationsDelta(t *testing.T) {
+	assert := assert.New(t)
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	vs := mockCombinedValidationService(fakeCombinedIstioDetails(), []string{"details", "product", "customer"}, fakePods())
+
+	badDR := data.AddSubsetToDestinationRule(data.CreateSubset("v9", "v9"), data.CreateEmptyDestinationRule("test", "details-dr", "details"))
+
+	added, removed, err := vs.GetValidationsDelta("test", "destinationrules", badDR)
+	assert.NoError(err)
+	assert.Empty(removed)
+	assert.Len(added, 1)
+
+	validation, found := added[models.IstioValidationKey{ObjectType: "destinationrule", Namespace: "test", Name: "details-dr"}]
+	assert.True(found)
+	assert.False(validation.Valid)
+	assert.Len(validation.Checks, 1)
+	assert.Equal("spec/subsets[0]", validation.Checks[0].Path)
+	assert.Equal(models.CheckMessage("destinationrules.nodest.subsetlabels"), validation.Checks[0].GetFullMessage())
+
+	_, _, err = vs.GetValidationsDelta("test", "unknowns", badDR)
+	assert.Error(err)
+}
+
+func TestProposedValidationsCache(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	dr := data.CreateEmptyDestinationRule("test", "details-dr", "details")
+	sameDR := data.CreateEmptyDestinationRule("test", "details-dr", "details")
+	changedDR := data.AddSubsetToDestinationRule(data.CreateSubset("v9", "v9"), data.CreateEmptyDestinationRule("test", "details-dr", "details"))
+
+	hash := proposedContentHash(kubernetes.DestinationRules, dr)
+	assert.NotEmpty(hash)
+	assert.Equal(hash, proposedContentHash(kubernetes.DestinationRules, sameDR))
+	assert.NotEqual(hash, proposedContentHash(kubernetes.DestinationRules, changedDR))
+	assert.Empty(proposedContentHash("unknowns", dr))
+
+	validations := models.IstioValidations{
+		models.IstioValidationKey{ObjectType: "destinationrule", Namespace: "test", Name: "details-dr"}: &models.IstioValidation{Valid: true},
+	}
+	now := time.Now()
+	setProposedValidations("test/destinationrules/details-dr/"+hash, now, validations)
+
+	cached, found := getProposedValidations("test/destinationrules/details-dr/"+hash, now.Add(time.Second))
+	assert.True(found)
+	assert.Equal(validations, cached)
+
+	_, found = getProposedValidations("test/destinationrules/details-dr/"+hash, now.Add(proposedValidationsCacheDuration))
+	assert.False(found)
+}
+
+func TestGetServiceDeletionImpact(t *testing.T) {
+	assert := assert.New(t)
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	istioDetails := kubernetes.IstioDetails{
+		VirtualServices: []kubernetes.IstioObject{
+			data.AddRoutesToVirtualService("http", data.CreateRoute("reviews", "v1", -1),
+				data.CreateEmptyVirtualService("reviews-vs", "test", []string{"reviews"})),
+			data.AddRoutesToVirtualService("http", data.CreateRoute("ratings", "v1", -1),
+				data.CreateEmptyVirtualService("ratings-vs", "test", []string{"ratings"})),
+		},
+		DestinationRules: []kubernetes.IstioObject{
+			data.CreateEmptyDestinationRule("test", "reviews-dr", "reviews.test.svc.cluster.local"),
+			data.CreateEmptyDestinationRule("test", "ratings-dr", "ratings"),
+		},
+	}
+	otherNsVirtualServices := []kubernetes.IstioObject{
+		data.AddRoutesToVirtualService("http", data.CreateRoute("reviews.test.svc.cluster.local", "v1", -1),
+			data.CreateEmptyVirtualService("reviews-fqdn-vs", "test2", []string{"reviews.test.svc.cluster.local"})),
+		// Refers to the reviews service of test2
+		data.AddRoutesToVirtualService("http", data.CreateRoute("reviews", "v1", -1),
+			data.CreateEmptyVirtualService("reviews-vs", "test2", []string{"reviews"})),
+	}
+	otherNsDestinationRules := []kubernetes.IstioObject{
+		data.CreateEmptyDestinationRule("test2", "reviews-dr", "reviews.test"),
+		data.CreateEmptyDestinationRule("test2", "local-reviews-dr", "reviews"),
+	}
+
+	k8s := new(kubetest.K8SClientMock)
+	k8s.On("IsOpenShift").Return(false)
+	k8s.On("IsMaistraApi").Return(false)
+	k8s.On("GetNamespace", "test").Return(kubetest.FakeNamespace("test"), nil)
+	k8s.On("GetNamespaces", "").Return(fakeNamespaces(), nil)
+	k8s.On("GetIstioObjects", "test", "virtualservices", "").Return(istioDetails.VirtualServices, nil)
+	k8s.On("GetIstioObjects", "test", "destinationrules", "").Return(istioDetails.DestinationRules, nil)
+	k8s.On("GetIstioObjects", "test2", "virtualservices", "").Return(otherNsVirtualServices, nil)
+	k8s.On("GetIstioObjects", "test2", "destinationrules", "").Return(otherNsDestinationRules, nil)
+	vs := IstioValidationsService{k8s: k8s, businessLayer: NewWithBackends(k8s, nil, nil)}
+
+	impacted, err := vs.GetServiceDeletionImpact("test", "reviews")
+	assert.NoError(err)
+	assert.Len(impacted, 4)
+	assert.Equal("VirtualService test/reviews-vs", impactedName(impacted[0]))
+	assert.Equal("VirtualService test2/reviews-fqdn-vs", impactedName(impacted[1]))
+	assert.Equal("DestinationRule test/reviews-dr", impactedName(impacted[2]))
+	assert.Equal("DestinationRule test2/reviews-dr", impactedName(impacted[3]))
+
+	_, err = vs.GetServiceDeletionImpact("test", "")
+	assert.Error(err)
+}
+
+func impactedName(object kubernetes.IstioObject) string {
+	return object.GetTypeMeta().Kind + " " + object.GetObjectMeta().Namespace + "/" + object.GetObjectMeta().Name
+}
+
+func TestGetSecurityValidations(t *testing.T) {
+	assert := assert.New(t)
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	istioDetails := fakeCombinedIstioDetails()
+	istioDetails.DestinationRules = append(istioDetails.DestinationRules,
+		data.AddTrafficPolicyToDestinationRule(data.CreateDisabledMTLSTrafficPolicyForDestinationRules(),
+			data.CreateEmptyDestinationRule("test", "details-dr", "details")))
+
+	k8s := new(kubetest.K8SClientMock)
+	k8s.On("GetIstioObjects", "test", "peerauthentications", "").Return([]kubernetes.IstioObject{
+		data.CreateEmptyPeerAuthentication("default", "test", data.CreateMTLS("STRICT")),
+	}, nil)
+	vs := mockCombinedValidationServiceWith(k8s, istioDetails, []string{"details", "product", "customer"}, fakePods())
+
+	subsetKey := models.IstioValidationKey{ObjectType: "destinationrule", Namespace: "test", Name: "product-dr"}
+	allValidations, err := vs.GetValidations("test", "")
+	assert.NoError(err)
+	assert.Len(allValidations[subsetKey].Checks, 1)
+
+	validations, err := vs.GetValidationsByCategory("test", models.SecurityValidationCategory)
+	assert.NoError(err)
+
+	// DR subset validations are traffic validations
+	_, found := validations[subsetKey]
+	assert.False(found)
+	_, found = validations[models.IstioValidationKey{ObjectType: "virtualservice", Namespace: "test", Name: "product-vs"}]
+	assert.False(found)
+
+	// mTLS conflicts are security validations
+	mtlsValidation, found := validations[models.IstioValidationKey{ObjectType: "destinationrule", Namespace: "test", Name: "details-dr"}]
+	if assert.True(found) {
+		assert.False(mtlsValidation.Valid)
+		assert.Len(mtlsValidation.Checks, 1)
+		assert.Equal(models.CheckMessage("destinationrules.mtls.policymtlsenabled"), mtlsValidation.Checks[0].GetFullMessage())
+	}
+
+	// PeerAuthentications are security objects
+	_, found = validations[models.IstioValidationKey{ObjectType: "peerauthentication", Namespace: "test", Name: "default"}]
+	assert.True(found)
+}
+
+func TestGetValidationMessageKeys(t *testing.T) {
+	assert := assert.New(t)
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	istioDetails := fakeCombinedIstioDetails()
+	istioDetails.DestinationRules = append(istioDetails.DestinationRules,
+		data.AddTrafficPolicyToDestinationRule(data.CreateDisabledMTLSTrafficPolicyForDestinationRules(),
+			data.CreateEmptyDestinationRule("test", "details-dr", "details")))
+
+	k8s := new(kubetest.K8SClientMock)
+	k8s.On("GetIstioObjects", "test", "peerauthentications", "").Return([]kubernetes.IstioObject{
+		data.CreateEmptyPeerAuthentication("default", "test", data.CreateMTLS("STRICT")),
+	}, nil)
+	vs := mockCombinedValidationServiceWith(k8s, istioDetails, []string{"details", "product", "customer"}, fakePods())
+
+	messageKeys, err := vs.GetValidationMessageKeys("test")
+	assert.NoError(err)
+	assert.Equal(1, messageKeys["destinationrules.mtls.policymtlsenabled"])
+	assert.Equal(1, messageKeys["destinationrules.nodest.subsetlabels"])
+	assert.NotContains(messageKeys, "virtualservices.nohost.hostnotfound")
+}
+
+func TestGetValidationRules(t *testing.T) {
+	assert := assert.New(t)
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	vs := IstioValidationsService{}
+	rules := vs.GetValidationRules()
+	assert.NotEmpty(rules)
+
+	rulesByKey := make(map[string]models.ValidationRule, len(rules))
+	for _, rule := range rules {
+		assert.NotEmpty(rule.ObjectTypes, rule.Key)
+		rulesByKey[rule.Key] = rule
+	}
+
+	subsetLabels := rulesByKey["destinationrules.nodest.subsetlabels"]
+	assert.Equal("KIA0203", subsetLabels.Code)
+	assert.Equal(models.ErrorSeverity, subsetLabels.Severity)
+	assert.Equal([]string{"destinationrule"}, subsetLabels.ObjectTypes)
+	assert.Equal(models.TrafficValidationCategory, subsetLabels.Category)
+	assert.Equal(models.SecurityValidationCategory, rulesByKey["destinationrules.mtls.nspolicymissing"].Category)
+
+	singleHost := rulesByKey["virtualservices.singlehost"]
+	assert.Equal("KIA1106", singleHost.Code)
+	assert.Equal(models.WarningSeverity, singleHost.Severity)
+	assert.Equal([]string{"virtualservice"}, singleHost.ObjectTypes)
+
+	portNumber := rulesByKey["port.number.outofrange"]
+	assert.Equal(models.ErrorSeverity, portNumber.Severity)
+	assert.ElementsMatch([]string{"gateway", "serviceentry"}, portNumber.ObjectTypes)
+	assert.Equal([]string{"gateway", "service", "serviceentry"}, rulesByKey["port.name.mismatch"].ObjectTypes)
+	assert.Equal([]string{"serviceentry"}, rulesByKey["port.name.duplicate"].ObjectTypes)
+
+	assert.Equal(models.Unknown, rulesByKey["validation.unable.cross-namespace"].Severity)
+}
+
+func TestValidationRuleObjectTypesAreCheckerTypes(t *testing.T) {
+	assert := assert.New(t)
+
+	checkerTypes := map[string]bool{
+		checkers.AuthorizationPolicyCheckerType:   true,
+		checkers.DestinationRuleCheckerType:       true,
+		checkers.EnvoyFilterCheckerType:           true,
+		checkers.GatewayCheckerType:               true,
+		checkers.PeerAuthenticationCheckerType:    true,
+		checkers.RequestAuthenticationCheckerType: true,
+		checkers.ServiceCheckerType:               true,
+		checkers.ServiceEntryCheckerType:          true,
+		checkers.ServiceRoleCheckerType:           true,
+		checkers.SidecarCheckerType:               true,
+		checkers.TelemetryCheckerType:             true,
+		checkers.VirtualCheckerType:               true,
+		checkers.WorkloadEntryCheckerType:         true,
+		"servicerolebinding":                      true,
+	}
+
+	for key := range models.CheckDescriptors() {
+		for _, objectType := range models.CheckObjectTypes(key) {
+			assert.True(checkerTypes[objectType], "%s reported by unknown object type %s", key, objectType)
+		}
+	}
+}
+
+func TestValidationsWithUnreadableMeshConfig(t *testing.T) {
+	assert := assert.New(t)
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	ap := data.CreateAuthorizationPolicy([]interface{}{"test"}, []interface{}{"GET"}, []interface{}{"details"}, map[string]interface{}{"app": "details"})
+	ap.GetSpec()["action"] = "CUSTOM"
+	ap.GetSpec()["provider"] = map[string]interface{}{"name": "ext-authz"}
+	apMeta := ap.GetObjectMeta()
+	apMeta.Namespace = "test"
+	ap.SetObjectMeta(apMeta)
+
+	k8s := new(kubetest.K8SClientMock)
+	k8s.On("GetConfigMap", conf.IstioNamespace, conf.ExternalServices.Istio.ConfigMapName).
+		Return((*core_v1.ConfigMap)(nil), k8s_errors.NewForbidden(core_v1.Resource("configmaps"), conf.ExternalServices.Istio.ConfigMapName, nil))
+	k8s.On("GetIstioObjects", "test", "authorizationpolicies", "").Return([]kubernetes.IstioObject{ap}, nil)
+	vs := mockCombinedValidationServiceWith(k8s, fakeCombinedIstioDetails(), []string{"details", "product", "customer"}, fakePods())
+
+	validations, err := vs.GetValidations("test", "")
+	assert.NoError(err)
+
+	// The AuthorizationPolicy is still validated, but its provider can't be verified
+	apValidation, found := validations[models.IstioValidationKey{ObjectType: "authorizationpolicy", Namespace: "test", Name: ap.GetObjectMeta().Name}]
+	if assert.True(found) {
+		for _, check := range apValidation.Checks {
+			assert.NotEqual(models.CheckMessage("authorizationpolicy.custom.providernotfound"), check.GetFullMessage())
+		}
+	}
+}
+
+func TestTelemetryValidations(t *testing.T) {
+	assert := assert.New(t)
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	telemetry := func(name, provider string) kubernetes.IstioObject {
+		return (&kubernetes.GenericIstioObject{
+			ObjectMeta: meta_v1.ObjectMeta{Name: name, Namespace: "test"},
+			Spec: map[string]interface{}{
+				"tracing": []interface{}{
+					map[string]interface{}{
+						"providers": []interface{}{map[string]interface{}{"name": provider}},
+					},
+				},
+			},
+		}).DeepCopyIstioObject()
+	}
+	istioDetails := fakeCombinedIstioDetails()
+	istioDetails.Telemetries = []kubernetes.IstioObject{telemetry("unknown-provider", "zipkn"), telemetry("built-in-provider", "stackdriver")}
+
+	vs := mockCombinedValidationService(istioDetails, []string{"details", "product", "customer"}, fakePods())
+
+	validations, err := vs.GetIstioObjectValidations("test", "telemetries", "unknown-provider")
+	assert.NoError(err)
+	validation, found := validations[models.IstioValidationKey{ObjectType: "telemetry", Namespace: "test", Name: "unknown-provider"}]
+	if assert.True(found) && assert.Len(validation.Checks, 1) {
+		assert.Equal(models.CheckMessage("telemetries.provider.notfound"), validation.Checks[0].GetFullMessage())
+	}
+
+	validations, err = vs.GetValidations("test", "")
+	assert.NoError(err)
+	validation, found = validations[models.IstioValidationKey{ObjectType: "telemetry", Namespace: "test", Name: "built-in-provider"}]
+	if assert.True(found) {
+		assert.Empty(validation.Checks)
+	}
+}
+
+func TestServiceAccountsPerNamespace(t *testing.T) {
+	assert := assert.New(t)
+
+	workloadsPerNamespace := map[string]models.WorkloadList{
+		"bookinfo": {Workloads: []models.WorkloadListItem{
+			{Name: "details-v1", ServiceAccountNames: []string{"bookinfo-details"}},
+			{Name: "reviews-v1", ServiceAccountNames: []string{"bookinfo-reviews"}},
+		}},
+		"scaled-down": {Workloads: []models.WorkloadListItem{
+			{Name: "ratings-v1"},
+		}},
+	}
+
+	serviceAccounts := serviceAccountsPerNamespace(workloadsPerNamespace)
+
+	assert.Equal(map[string][]string{"bookinfo": {"bookinfo-details", "bookinfo-reviews"}}, serviceAccounts)
+}
+
+func TestFetchGatewaySecrets(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	gw := data.AddServerToGateway(data.CreateServer([]string{"*.local"}, 443, "https", "HTTPS"),
+		data.CreateEmptyGateway("ingress", "test", map[string]string{"istio": "ingressgateway"}))
+	gw.GetSpec()["servers"].([]interface{})[0].(map[string]interface{})["tls"] = map[string]interface{}{"mode": "SIMPLE", "credentialName": "test-credential"}
+	workloadsPerNamespace := map[string]models.WorkloadList{
+		"istio-system": data.CreateWorkloadList("istio-system", data.CreateWorkloadListItem("istio-ingressgateway", map[string]string{"istio": "ingressgateway"})),
+		"test":         data.CreateWorkloadList("test", data.CreateWorkloadListItem("test-ingressgateway", map[string]string{"istio": "ingressgateway"})),
+	}
+
+	k8s := new(kubetest.K8SClientMock)
+	k8s.On("GetSecrets", "istio-system", "").Return([]core_v1.Secret{}, k8s_errors.NewForbidden(core_v1.Resource("secrets"), "", nil))
+	k8s.On("GetSecrets", "test", "").Return([]core_v1.Secret{{ObjectMeta: meta_v1.ObjectMeta{Name: "test-credential", Namespace: "test"}}}, nil)
+	vs := IstioValidationsService{k8s: k8s}
+
+	secrets := vs.fetchGatewaySecrets([]kubernetes.IstioObject{gw}, workloadsPerNamespace)
+
+	// Unreadable namespaces are left out
+	assert.Len(secrets, 1)
+	assert.Len(secrets["test"], 1)
+}
+
+func TestRegistryStatusPerCluster(t *testing.T) {
+	assert := assert.New(t)
+
+	reviews := kubernetes.RegistryStatus{}
+	reviews.Hostname = "reviews.bookinfo.svc.cluster.local"
+	reviews.ClusterVIPs = map[string]string{"primary": "10.0.0.10", "remote": "10.1.0.10"}
+	ratings := kubernetes.RegistryStatus{}
+	ratings.Hostname = "ratings.bookinfo.svc.cluster.local"
+	ratings.ClusterVIPs = map[string]string{"remote": "10.1.0.11"}
+
+	clusterRegistryStatus := registryStatusPerCluster([]*kubernetes.RegistryStatus{&reviews, &ratings})
+
+	assert.Len(clusterRegistryStatus, 2)
+	assert.Equal([]*kubernetes.RegistryStatus{&reviews}, clusterRegistryStatus["primary"])
+	assert.Equal([]*kubernetes.RegistryStatus{&reviews, &ratings}, clusterRegistryStatus["remote"])
+}
+
 func mockWorkLoadService(k8s *kubetest.K8SClientMock) WorkloadService {
 	// Setup mocks
 	k8s.On("IsOpenShift").Return(true)
@@ -88,6 +455,9 @@ func mockMultiNamespaceGatewaysValidationService() IstioValidationsService {
 	k8s.On("GetMeshPolicies", mock.AnythingOfType("string")).Return(fakeMeshPolicies(), nil)
 	k8s.On("GetIstioObjects", mock.AnythingOfType("string"), "peerauthentications", "").Return(fakePolicies(), nil)
 	k8s.On("GetIstioObjects", mock.AnythingOfType("string"), "requestauthentications", "").Return([]kubernetes.IstioObject{}, nil)
+	k8s.On("GetIstioObjects", mock.AnythingOfType("string"), "envoyfilters", "").Return([]kubernetes.IstioObject{}, nil)
+	k8s.On("GetIstioObjects", mock.AnythingOfType("string"), "workloadentries", "").Return([]kubernetes.IstioObject{}, nil)
+	k8s.On("GetIstioObjects", mock.AnythingOfType("string"), "telemetries", "").Return([]kubernetes.IstioObject{}, nil)
 	k8s.On("GetIstioObjects", mock.AnythingOfType("string"), "clusterrbacconfigs", "").Return([]kubernetes.IstioObject{}, nil)
 	k8s.On("GetIstioObjects", mock.AnythingOfType("string"), "authorizationpolicies", "").Return([]kubernetes.IstioObject{}, nil)
 	k8s.On("GetIstioObjects", mock.AnythingOfType("string"), "servicerolebindings", "").Return([]kubernetes.IstioObject{}, nil)
@@ -99,13 +469,20 @@ func mockMultiNamespaceGatewaysValidationService() IstioValidationsService {
 }
 
 func mockCombinedValidationService(istioObjects *kubernetes.IstioDetails, services []string, podList *core_v1.PodList) IstioValidationsService {
-	k8s := new(kubetest.K8SClientMock)
+	return mockCombinedValidationServiceWith(new(kubetest.K8SClientMock), istioObjects, services, podList)
+}
+
+// mockCombinedValidationServiceWith sets up the combined mocks on k8s, after any expectation already set on it
+func mockCombinedValidationServiceWith(k8s *kubetest.K8SClientMock, istioObjects *kubernetes.IstioDetails, services []string, podList *core_v1.PodList) IstioValidationsService {
 	k8s.On("GetIstioObjects", mock.AnythingOfType("string"), "sidecars", "").Return(istioObjects.Sidecars, nil)
 	k8s.On("GetIstioObjects", mock.AnythingOfType("string"), "requestauthentications", "").Return(istioObjects.RequestAuthentications, nil)
+	k8s.On("GetIstioObjects", mock.AnythingOfType("string"), "envoyfilters", "").Return(istioObjects.EnvoyFilters, nil)
+	k8s.On("GetIstioObjects", mock.AnythingOfType("string"), "workloadentries", "").Return(istioObjects.WorkloadEntries, nil)
+	k8s.On("GetIstioObjects", mock.AnythingOfType("string"), "telemetries", "").Return(istioObjects.Telemetries, nil)
 	k8s.On("GetServices", mock.AnythingOfType("string"), mock.AnythingOfType("map[string]string")).Return(fakeCombinedServices(services), nil)
 	k8s.On("GetDeployments", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(FakeDepSyncedWithRS(), nil)
 	k8s.On("GetIstioObjects", mock.AnythingOfType("string"), "virtualservices", "").Return(fakeCombinedIstioDetails().VirtualServices, nil)
-	k8s.On("GetIstioObjects", mock.AnythingOfType("string"), "destinationrules", "").Return(fakeCombinedIstioDetails().DestinationRules, nil)
+	k8s.On("GetIstioObjects", mock.AnythingOfType("string"), "destinationrules", "").Return(istioObjects.DestinationRules, nil)
 	k8s.On("GetIstioObjects", mock.AnythingOfType("string"), "authorizationpolicies", "").Return([]kubernetes.IstioObject{}, nil)
 	k8s.On("GetIstioObjects", mock.AnythingOfType("string"), "clusterrbacconfigs", "").Return([]kubernetes.IstioObject{}, nil)
 	k8s.On("GetIstioObjects", mock.AnythingOfType("string"), "servicerolebindings", "").Return([]kubernetes.IstioObject{}, nil)
@@ -1,10 +1,13 @@
 package business
 
 import (
+	"fmt"
 	"sync"
 
 	core_v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
 
+	"github.com/kiali/kiali/business/checkers/common"
 	"github.com/kiali/kiali/config"
 	"github.com/kiali/kiali/kubernetes"
 	"github.com/kiali/kiali/models"
@@ -47,6 +50,152 @@ func (in *TLSService) MeshWidemTLSStatus(namespaces []string) (models.MTLSStatus
 	}, nil
 }
 
+// WorkloadsSecurityPosture returns, for every workload of the namespace, the effective mTLS status
+// together with the PeerAuthentications and AuthorizationPolicies applied to it.
+func (in *TLSService) WorkloadsSecurityPosture(namespace string) ([]models.WorkloadSecurityPosture, error) {
+	workloads, err := in.businessLayer.Workload.GetWorkloadList(namespace, false)
+	if err != nil {
+		return nil, err
+	}
+
+	meshPas, err := in.getMeshPeerAuthentications()
+	if err != nil {
+		return nil, err
+	}
+
+	pas, err := in.getPeerAuthentications(namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	nss, err := in.getNamespaces()
+	if err != nil {
+		return nil, err
+	}
+
+	drs, err := in.getAllDestinationRules(nss)
+	if err != nil {
+		return nil, err
+	}
+
+	aps, err := in.getAuthorizationPolicies(namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var services []core_v1.Service
+	if IsNamespaceCached(namespace) {
+		services, err = kialiCache.GetServices(namespace, nil)
+	} else {
+		services, err = in.k8s.GetServices(namespace, nil)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	mtlsDetails := kubernetes.MTLSDetails{
+		DestinationRules:        drs,
+		MeshPeerAuthentications: meshPas,
+		PeerAuthentications:     pas,
+		EnabledAutoMtls:         in.hasAutoMTLSEnabled(),
+	}
+
+	postures := make([]models.WorkloadSecurityPosture, 0, len(workloads.Workloads))
+	for _, wk := range workloads.Workloads {
+		postures = append(postures, workloadSecurityPosture(namespace, wk, mtlsDetails, aps, services))
+	}
+
+	return postures, nil
+}
+
+// workloadSecurityPosture resolves the posture of a single workload.
+// Workload-level PeerAuthentications take precedence over namespace-wide and mesh-wide ones.
+func workloadSecurityPosture(namespace string, wk models.WorkloadListItem, mtlsDetails kubernetes.MTLSDetails, authPolicies []kubernetes.IstioObject, services []core_v1.Service) models.WorkloadSecurityPosture {
+	wkLabels := labels.Set(wk.Labels)
+	posture := models.WorkloadSecurityPosture{
+		Workload:              wk.Name,
+		PeerAuthentications:   make([]string, 0),
+		AuthorizationPolicies: make([]string, 0),
+	}
+
+	posture.MTLSStatus = mtls.MtlsStatus{
+		Namespace:           namespace,
+		PeerAuthentications: mtlsDetails.PeerAuthentications,
+		DestinationRules:    mtlsDetails.DestinationRules,
+		MatchingLabels:      wkLabels,
+		Services:            services,
+		AutoMtlsEnabled:     mtlsDetails.EnabledAutoMtls,
+	}.WorkloadMtlsStatus()
+
+	for _, pa := range mtlsDetails.PeerAuthentications {
+		if appliesToLabels(common.GetSelectorLabels(pa), wkLabels) {
+			posture.PeerAuthentications = append(posture.PeerAuthentications, istioObjectReference(pa))
+		}
+	}
+
+	if posture.MTLSStatus == MTLSNotEnabled {
+		// No workload-level PeerAuthentication, so namespace-wide and mesh-wide settings apply
+		posture.MTLSStatus = namespaceOverallMtlsStatus(namespace, mtlsDetails)
+
+		for _, pa := range mtlsDetails.MeshPeerAuthentications {
+			if len(posture.PeerAuthentications) == 0 && !pa.HasMatchLabelsSelector() {
+				posture.PeerAuthentications = append(posture.PeerAuthentications, istioObjectReference(pa))
+			}
+		}
+	}
+
+	for _, ap := range authPolicies {
+		if appliesToLabels(common.GetSelectorLabels(ap), wkLabels) {
+			posture.AuthorizationPolicies = append(posture.AuthorizationPolicies, istioObjectReference(ap))
+		}
+	}
+
+	posture.Protected = wk.IstioSidecar && posture.MTLSStatus == MTLSEnabled && len(posture.AuthorizationPolicies) > 0
+
+	return posture
+}
+
+// namespaceOverallMtlsStatus combines the namespace-wide and mesh-wide PeerAuthentications and DestinationRules
+// into the mTLS status of the namespace. Namespace-wide settings take precedence over mesh-wide ones.
+func namespaceOverallMtlsStatus(namespace string, mtlsDetails kubernetes.MTLSDetails) string {
+	mtlsStatus := mtls.MtlsStatus{
+		Namespace:        namespace,
+		DestinationRules: mtlsDetails.DestinationRules,
+		AutoMtlsEnabled:  mtlsDetails.EnabledAutoMtls,
+	}
+	nsStatus := mtls.MtlsStatus{
+		Namespace:           namespace,
+		PeerAuthentications: mtlsDetails.PeerAuthentications,
+		DestinationRules:    mtlsDetails.DestinationRules,
+		AutoMtlsEnabled:     mtlsDetails.EnabledAutoMtls,
+	}.NamespaceMtlsStatus()
+	meshStatus := mtls.MtlsStatus{
+		PeerAuthentications: mtlsDetails.MeshPeerAuthentications,
+		DestinationRules:    mtlsDetails.DestinationRules,
+		AutoMtlsEnabled:     mtlsDetails.EnabledAutoMtls,
+	}.MeshMtlsStatus()
+	return mtlsStatus.OverallMtlsStatus(nsStatus, meshStatus)
+}
+
+// appliesToLabels returns true when an object without selector (namespace-wide) or with a selector matching wkLabels
+func appliesToLabels(selectorLabels map[string]string, wkLabels labels.Set) bool {
+	if len(selectorLabels) == 0 {
+		return true
+	}
+	return labels.SelectorFromSet(selectorLabels).Matches(wkLabels)
+}
+
+func istioObjectReference(object kubernetes.IstioObject) string {
+	return fmt.Sprintf("%s/%s", object.GetObjectMeta().Namespace, object.GetObjectMeta().Name)
+}
+
+func (in *TLSService) getAuthorizationPolicies(namespace string) ([]kubernetes.IstioObject, error) {
+	if IsResourceCached(namespace, kubernetes.AuthorizationPolicies) {
+		return kialiCache.GetIstioObjects(namespace, kubernetes.AuthorizationPolicies, "")
+	}
+	return in.k8s.GetIstioObjects(namespace, kubernetes.AuthorizationPolicies, "")
+}
+
 func (in *TLSService) getMeshPeerAuthentications() ([]kubernetes.IstioObject, error) {
 	var mps []kubernetes.IstioObject
 	var err error
@@ -134,6 +283,41 @@ func (in TLSService) NamespaceWidemTLSStatus(namespace string) (models.MTLSStatu
 	}, nil
 }
 
+// MeshMTLSStatus returns the mTLS status of the namespace, combining the namespace-wide and mesh-wide
+// PeerAuthentication modes with the DestinationRule tls modes of all the namespaces.
+func (in *TLSService) MeshMTLSStatus(namespace string) (models.MTLSStatus, error) {
+	meshPas, err := in.getMeshPeerAuthentications()
+	if err != nil {
+		return models.MTLSStatus{}, err
+	}
+
+	pas, err := in.getPeerAuthentications(namespace)
+	if err != nil {
+		return models.MTLSStatus{}, err
+	}
+
+	nss, err := in.getNamespaces()
+	if err != nil {
+		return models.MTLSStatus{}, err
+	}
+
+	drs, err := in.getAllDestinationRules(nss)
+	if err != nil {
+		return models.MTLSStatus{}, err
+	}
+
+	mtlsDetails := kubernetes.MTLSDetails{
+		DestinationRules:        drs,
+		MeshPeerAuthentications: meshPas,
+		PeerAuthentications:     pas,
+		EnabledAutoMtls:         in.hasAutoMTLSEnabled(),
+	}
+
+	return models.MTLSStatus{
+		Status: namespaceOverallMtlsStatus(namespace, mtlsDetails),
+	}, nil
+}
+
 func (in TLSService) getPeerAuthentications(namespace string) ([]kubernetes.IstioObject, error) {
 	if namespace == config.Get().IstioNamespace {
 		return []kubernetes.IstioObject{}, nil
@@ -263,6 +263,58 @@ func testNamespaceScenario(exStatus string, drs []kubernetes.IstioObject, ps []k
 	assert.Equal(exStatus, status.Status)
 }
 
+func TestMeshMTLSStatusStrictEverywhere(t *testing.T) {
+	meshPs := fakeStrictMeshPeerAuthentication("default")
+	drs := []kubernetes.IstioObject{
+		data.AddTrafficPolicyToDestinationRule(data.CreateMTLSTrafficPolicyForDestinationRules(),
+			data.CreateEmptyDestinationRule("bookinfo", "allow-mtls", "*.bookinfo.svc.cluster.local")),
+	}
+
+	testMeshMTLSStatusScenario(MTLSEnabled, drs, []kubernetes.IstioObject{}, meshPs, false, t)
+	testMeshMTLSStatusScenario(MTLSEnabled, []kubernetes.IstioObject{}, []kubernetes.IstioObject{}, meshPs, true, t)
+}
+
+func TestMeshMTLSStatusPermissiveNamespace(t *testing.T) {
+	ps := fakePermissivePeerAuthn("default", "bookinfo")
+
+	testMeshMTLSStatusScenario(MTLSPartiallyEnabled, []kubernetes.IstioObject{}, ps, []kubernetes.IstioObject{}, false, t)
+	testMeshMTLSStatusScenario(MTLSPartiallyEnabled, []kubernetes.IstioObject{}, ps, fakeStrictMeshPeerAuthentication("default"), true, t)
+}
+
+func TestMeshMTLSStatusMixedNamespace(t *testing.T) {
+	meshPs := fakeStrictMeshPeerAuthentication("default")
+	ps := fakePeerAuthnWithMtlsMode("default", "bookinfo", "DISABLE")
+	drs := []kubernetes.IstioObject{
+		data.AddTrafficPolicyToDestinationRule(data.CreateDisabledMTLSTrafficPolicyForDestinationRules(),
+			data.CreateEmptyDestinationRule("bookinfo", "disable-mtls", "*.bookinfo.svc.cluster.local")),
+	}
+
+	testMeshMTLSStatusScenario(MTLSDisabled, drs, ps, meshPs, false, t)
+	testMeshMTLSStatusScenario(MTLSDisabled, []kubernetes.IstioObject{}, ps, meshPs, true, t)
+}
+
+func testMeshMTLSStatusScenario(exStatus string, drs, ps, meshPs []kubernetes.IstioObject, autoMtls bool, t *testing.T) {
+	assert := assert.New(t)
+
+	k8s := new(kubetest.K8SClientMock)
+	k8s.On("IsOpenShift").Return(true)
+	k8s.On("IsMaistraApi").Return(false)
+	k8s.On("GetProjects", mock.AnythingOfType("string")).Return(fakeProjects(), nil)
+	k8s.On("GetIstioObjects", "bookinfo", "destinationrules", "").Return(drs, nil)
+	k8s.On("GetIstioObjects", "foo", "destinationrules", "").Return([]kubernetes.IstioObject{}, nil)
+	k8s.On("GetIstioObjects", "bookinfo", "peerauthentications", "").Return(ps, nil)
+	k8s.On("GetIstioObjects", "istio-system", "peerauthentications", "").Return(meshPs, nil)
+
+	config.Set(config.NewConfig())
+
+	tlsService := TLSService{k8s: k8s, enabledAutoMtls: &autoMtls, businessLayer: NewWithBackends(k8s, nil, nil)}
+	tlsService.businessLayer.Namespace.isAccessibleNamespaces["**"] = true
+	status, err := tlsService.MeshMTLSStatus("bookinfo")
+
+	assert.NoError(err)
+	assert.Equal(exStatus, status.Status)
+}
+
 func fakeProjects() []osproject_v1.Project {
 	return []osproject_v1.Project{
 		{
@@ -316,3 +368,47 @@ func fakeMeshPeerAuthenticationWithMtlsMode(name, mTLSmode string) []kubernetes.
 func fakeMeshPeerAuthentication(name string, mtls interface{}) []kubernetes.IstioObject {
 	return []kubernetes.IstioObject{data.CreateEmptyMeshPeerAuthentication(name, mtls)}
 }
+
+func TestWorkloadSecurityPostureStrictCovered(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	pa := data.CreateEmptyPeerAuthenticationWithSelector("details-strict", "bookinfo", data.CreateOneLabelSelector("details"))
+	pa.GetSpec()["mtls"] = data.CreateMTLS("STRICT")
+	ap := data.CreateAuthorizationPolicy([]interface{}{"bookinfo"}, []interface{}{"GET"}, []interface{}{"details"},
+		map[string]interface{}{"app": "details"})
+
+	wk := data.CreateWorkloadListItem("details-v1", map[string]string{"app": "details", "version": "v1"})
+	wk.IstioSidecar = true
+
+	mtlsDetails := kubernetes.MTLSDetails{PeerAuthentications: []kubernetes.IstioObject{pa}}
+	posture := workloadSecurityPosture("bookinfo", wk, mtlsDetails, []kubernetes.IstioObject{ap}, nil)
+
+	assert.Equal("details-v1", posture.Workload)
+	assert.Equal(MTLSEnabled, posture.MTLSStatus)
+	assert.Equal([]string{"bookinfo/details-strict"}, posture.PeerAuthentications)
+	assert.Equal([]string{"bookinfo/auth-policy"}, posture.AuthorizationPolicies)
+	assert.True(posture.Protected)
+}
+
+func TestWorkloadSecurityPostureUncovered(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	pa := data.CreateEmptyPeerAuthenticationWithSelector("details-strict", "bookinfo", data.CreateOneLabelSelector("details"))
+	pa.GetSpec()["mtls"] = data.CreateMTLS("STRICT")
+	ap := data.CreateAuthorizationPolicy([]interface{}{"bookinfo"}, []interface{}{"GET"}, []interface{}{"details"},
+		map[string]interface{}{"app": "details"})
+
+	wk := data.CreateWorkloadListItem("ratings-v1", map[string]string{"app": "ratings", "version": "v1"})
+	wk.IstioSidecar = true
+
+	mtlsDetails := kubernetes.MTLSDetails{PeerAuthentications: []kubernetes.IstioObject{pa}}
+	posture := workloadSecurityPosture("bookinfo", wk, mtlsDetails, []kubernetes.IstioObject{ap}, nil)
+
+	assert.Equal("ratings-v1", posture.Workload)
+	assert.Equal(MTLSNotEnabled, posture.MTLSStatus)
+	assert.Empty(posture.PeerAuthentications)
+	assert.Empty(posture.AuthorizationPolicies)
+	assert.False(posture.Protected)
+}
@@ -351,6 +351,8 @@ type UIDefaults struct {
 // Validations defines default settings configured for the Validations subsystem
 type Validations struct {
 	Ignore []string `yaml:"ignore,omitempty" json:"ignore,omitempty"`
+	// NamingConventions maps an Istio object type (i.e. "virtualservices") to the regex its names must fully match
+	NamingConventions map[string]string `yaml:"naming_conventions,omitempty" json:"namingConventions,omitempty"`
 }
 
 // KialiFeatureFlags available from the CR
@@ -567,7 +569,8 @@ func NewConfig() (c *Config) {
 				RefreshInterval:   "15s",
 			},
 			Validations: Validations{
-				Ignore: make([]string, 0),
+				Ignore:            make([]string, 0),
+				NamingConventions: make(map[string]string),
 			},
 		},
 		KubernetesConfig: KubernetesConfig{
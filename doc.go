@@ -187,7 +187,7 @@ type WorkloadParam struct {
 
 // swagger:parameters graphApp graphAppVersion graphNamespaces graphService graphWorkload
 type AppendersParam struct {
-	// Comma-separated list of Appenders to run. Available appenders: [aggregateNode, deadNode, healthConfig, idleNode, istio, responseTime, securityPolicy, serviceEntry, sidecarsCheck, throughput].
+	// Comma-separated list of Appenders to run. Available appenders: [aggregateNode, deadNode, healthConfig, idleNode, istio, predominantProtocol, responseTime, securityPolicy, serviceEntry, sidecarsCheck, throughput].
 	//
 	// in: query
 	// required: false
@@ -294,6 +294,15 @@ type RateTcpParam struct {
 	Name string `json:"rateTcp"`
 }
 
+// swagger:parameters graphApp graphAppVersion graphNamespaces graphService graphWorkload
+type ResponseCodeClassParam struct {
+	// Scope request traffic to a response code class. One of: 2xx | 3xx | 4xx | 5xx. gRPC requests are classified by their gRPC status. TCP traffic and gRPC messages carry no response code and are left out when set. Default is all response codes.
+	//
+	// in: query
+	// required: false
+	Name string `json:"responseCodeClass"`
+}
+
 // swagger:parameters graphApp graphAppVersion graphNamespaces graphService graphWorkload
 type ResponseTimeParam struct {
 	// Used only with responseTime appender. One of: avg | 50 | 95 | 99.
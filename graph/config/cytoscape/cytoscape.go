@@ -119,6 +119,7 @@ type EdgeData struct {
 	// App Fields (not required by Cytoscape)
 	DestPrincipal   string          `json:"destPrincipal,omitempty"`   // principal used for the edge destination
 	IsMTLS          string          `json:"isMTLS,omitempty"`          // set to the percentage of traffic using a mutual TLS connection
+	Protocol        string          `json:"protocol,omitempty"`        // predominant protocol between the edge nodes (http, grpc or tcp)
 	ResponseTime    string          `json:"responseTime,omitempty"`    // in millis
 	SourcePrincipal string          `json:"sourcePrincipal,omitempty"` // principal used for the edge source
 	Throughput      string          `json:"throughput,omitempty"`      // in bytes/sec (request or response, depends on client request)
@@ -371,6 +372,9 @@ func buildConfig(trafficMap graph.TrafficMap, nodes *[]*NodeWrapper, edges *[]*E
 			if e.Metadata[graph.SourcePrincipal] != nil {
 				ed.SourcePrincipal = e.Metadata[graph.SourcePrincipal].(string)
 			}
+			if e.Metadata[graph.PredominantProtocol] != nil {
+				ed.Protocol = e.Metadata[graph.PredominantProtocol].(string)
+			}
 			addEdgeTelemetry(e, &ed)
 
 			ew := EdgeWrapper{
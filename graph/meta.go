@@ -35,6 +35,7 @@ const (
 	IsOutside             MetadataKey = "isOutside"
 	IsRoot                MetadataKey = "isRoot"
 	IsServiceEntry        MetadataKey = "isServiceEntry"
+	PredominantProtocol   MetadataKey = "predominantProtocol" // protocol with the highest rate between the edge nodes
 	ProtocolKey           MetadataKey = "protocol"
 	ResponseTime          MetadataKey = "responseTime"
 	SourcePrincipal       MetadataKey = "sourcePrincipal"
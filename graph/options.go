@@ -38,6 +38,10 @@ const (
 	RateRequests              string = "requests" // request count
 	RateSent                  string = "sent"     // tcp bytes sent, grpc request messages, etc
 	RateTotal                 string = "total"    // Sent+Received
+	ResponseCodeClass2xx      string = "2xx"
+	ResponseCodeClass3xx      string = "3xx"
+	ResponseCodeClass4xx      string = "4xx"
+	ResponseCodeClass5xx      string = "5xx"
 	defaultBoxBy              string = BoxByNone
 	defaultDuration           string = "10m"
 	defaultGraphType          string = GraphTypeWorkload
@@ -98,6 +102,7 @@ type TelemetryOptions struct {
 	InjectServiceNodes   bool               // inject destination service nodes between source and destination nodes.
 	Namespaces           NamespaceInfoMap
 	Rates                RequestedRates
+	ResponseCodeClass    string // scope request traffic to a response code class (e.g. 5xx), all codes when empty
 	CommonOptions
 	NodeOptions
 }
@@ -140,6 +145,7 @@ func NewOptions(r *net_http.Request) Options {
 	rateGrpc := params.Get("rateGrpc")
 	rateHttp := params.Get("rateHttp")
 	rateTcp := params.Get("rateTcp")
+	responseCodeClass := params.Get("responseCodeClass")
 	telemetryVendor := params.Get("telemetryVendor")
 
 	if _, ok := params["appenders"]; ok {
@@ -317,6 +323,21 @@ func NewOptions(r *net_http.Request) Options {
 		}
 	}
 
+	// Process Response Code Class Option, all response codes by default
+
+	if responseCodeClass != "" {
+		switch responseCodeClass {
+		case ResponseCodeClass2xx, ResponseCodeClass3xx, ResponseCodeClass4xx, ResponseCodeClass5xx:
+		default:
+			BadRequest(fmt.Sprintf("Invalid response code class [%s]", responseCodeClass))
+		}
+		// TCP bytes and gRPC messages carry no response code, only requests can be scoped to a class
+		rates.Tcp = RateNone
+		if rates.Grpc != RateRequests {
+			rates.Grpc = RateNone
+		}
+	}
+
 	// Service graphs require service injection
 	if graphType == GraphTypeService {
 		injectServiceNodes = true
@@ -341,6 +362,7 @@ func NewOptions(r *net_http.Request) Options {
 			InjectServiceNodes:   injectServiceNodes,
 			Namespaces:           namespaceMap,
 			Rates:                rates,
+			ResponseCodeClass:    responseCodeClass,
 			CommonOptions: CommonOptions{
 				Duration:  time.Duration(duration),
 				GraphType: graphType,
@@ -31,6 +31,8 @@ func ParseAppenders(o graph.TelemetryOptions) []graph.Appender {
 				requestedAppenders[IdleNodeAppenderName] = true
 			case IstioAppenderName:
 				requestedAppenders[IstioAppenderName] = true
+			case PredominantProtocolAppenderName:
+				requestedAppenders[PredominantProtocolAppenderName] = true
 			case ResponseTimeAppenderName:
 				requestedAppenders[ResponseTimeAppenderName] = true
 			case SecurityPolicyAppenderName:
@@ -169,6 +171,10 @@ func ParseAppenders(o graph.TelemetryOptions) []graph.Appender {
 		}
 		appenders = append(appenders, a)
 	}
+	if _, ok := requestedAppenders[PredominantProtocolAppenderName]; ok || o.Appenders.All {
+		a := PredominantProtocolAppender{}
+		appenders = append(appenders, a)
+	}
 
 	return appenders
 }
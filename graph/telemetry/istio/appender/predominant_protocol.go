@@ -0,0 +1,55 @@
+package appender
+
+import (
+	"github.com/kiali/kiali/graph"
+)
+
+const PredominantProtocolAppenderName = "predominantProtocol"
+
+// PredominantProtocolAppender is responsible for labeling each edge with the protocol carrying the
+// highest rate between its source and dest nodes. The graph has an edge per protocol between two nodes,
+// so the label lets the edges be grouped by the traffic that prevails (http, grpc or tcp).
+// Name: predominantProtocol
+type PredominantProtocolAppender struct{}
+
+// Name implements Appender
+func (a PredominantProtocolAppender) Name() string {
+	return PredominantProtocolAppenderName
+}
+
+// AppendGraph implements Appender
+func (a PredominantProtocolAppender) AppendGraph(trafficMap graph.TrafficMap, globalInfo *graph.AppenderGlobalInfo, namespaceInfo *graph.AppenderNamespaceInfo) {
+	if len(trafficMap) == 0 {
+		return
+	}
+
+	for _, n := range trafficMap {
+		edgesPerDest := make(map[string][]*graph.Edge)
+		for _, e := range n.Edges {
+			edgesPerDest[e.Dest.ID] = append(edgesPerDest[e.Dest.ID], e)
+		}
+
+		for _, edges := range edgesPerDest {
+			predominantProtocol := ""
+			highestRate := -1.0
+			for _, e := range edges {
+				protocol, ok := e.Metadata[graph.ProtocolKey].(string)
+				if !ok {
+					continue
+				}
+				// the total rate of an edge is keyed by its protocol name
+				rate, _ := e.Metadata[graph.MetadataKey(protocol)].(float64)
+				if rate > highestRate {
+					predominantProtocol = protocol
+					highestRate = rate
+				}
+			}
+			if predominantProtocol == "" {
+				continue
+			}
+			for _, e := range edges {
+				e.Metadata[graph.PredominantProtocol] = predominantProtocol
+			}
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package appender
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/graph"
+)
+
+func TestPredominantProtocolHttpOnly(t *testing.T) {
+	assert := assert.New(t)
+
+	trafficMap, source, _ := predominantProtocolTrafficMap(map[string]float64{"http": 10.0})
+	PredominantProtocolAppender{}.AppendGraph(trafficMap, nil, nil)
+
+	assert.Len(source.Edges, 1)
+	assert.Equal("http", source.Edges[0].Metadata[graph.PredominantProtocol])
+}
+
+func TestPredominantProtocolTcpOnly(t *testing.T) {
+	assert := assert.New(t)
+
+	trafficMap, source, _ := predominantProtocolTrafficMap(map[string]float64{"tcp": 150.0})
+	PredominantProtocolAppender{}.AppendGraph(trafficMap, nil, nil)
+
+	assert.Len(source.Edges, 1)
+	assert.Equal("tcp", source.Edges[0].Metadata[graph.PredominantProtocol])
+}
+
+func TestPredominantProtocolMixed(t *testing.T) {
+	assert := assert.New(t)
+
+	trafficMap, source, dest := predominantProtocolTrafficMap(map[string]float64{"http": 10.0, "grpc": 25.0, "tcp": 5.0})
+	other := graph.NewNode("east", "bookinfo", "ratings", "bookinfo", "ratings-v1", "ratings", "v1", graph.GraphTypeApp)
+	trafficMap[other.ID] = &other
+	otherEdge := source.AddEdge(&other)
+	otherEdge.Metadata[graph.ProtocolKey] = "http"
+	otherEdge.Metadata[graph.MetadataKey("http")] = 1.0
+
+	PredominantProtocolAppender{}.AppendGraph(trafficMap, nil, nil)
+
+	assert.Len(source.Edges, 4)
+	for _, e := range source.Edges {
+		if e.Dest.ID == dest.ID {
+			assert.Equal("grpc", e.Metadata[graph.PredominantProtocol])
+		} else {
+			assert.Equal("http", e.Metadata[graph.PredominantProtocol])
+		}
+	}
+}
+
+// predominantProtocolTrafficMap returns a trafficMap with an edge per protocol, and its rate, between two app nodes
+func predominantProtocolTrafficMap(rates map[string]float64) (graph.TrafficMap, *graph.Node, *graph.Node) {
+	trafficMap := graph.NewTrafficMap()
+
+	source := graph.NewNode("east", "bookinfo", "productpage", "bookinfo", "productpage-v1", "productpage", "v1", graph.GraphTypeApp)
+	dest := graph.NewNode("east", "bookinfo", "reviews", "bookinfo", "reviews-v1", "reviews", "v1", graph.GraphTypeApp)
+	trafficMap[source.ID] = &source
+	trafficMap[dest.ID] = &dest
+
+	for protocol, rate := range rates {
+		edge := source.AddEdge(&dest)
+		edge.Metadata[graph.ProtocolKey] = protocol
+		edge.Metadata[graph.MetadataKey(protocol)] = rate
+	}
+
+	return trafficMap, &source, &dest
+}
@@ -91,11 +91,13 @@ func buildNamespaceTrafficMap(namespace string, o graph.TelemetryOptions, client
 	// HTTP/GRPC request traffic
 	if o.Rates.Http == graph.RateRequests || o.Rates.Grpc == graph.RateRequests {
 		metric := "istio_requests_total"
+		codeFilter := responseCodeFilter(o)
 		groupBy := "source_cluster,source_workload_namespace,source_workload,source_canonical_service,source_canonical_revision,destination_cluster,destination_service_namespace,destination_service,destination_service_name,destination_workload_namespace,destination_workload,destination_canonical_service,destination_canonical_revision,request_protocol,response_code,grpc_response_status,response_flags"
 
 		// 0) Incoming: query source telemetry to capture unserviced namespace services' incoming traffic
-		query := fmt.Sprintf(`sum(rate(%s{reporter="source",source_workload_namespace!="%s",destination_workload_namespace="unknown",destination_workload="unknown",destination_service=~"^.+\\.%s\\..+$"} [%vs])) by (%s) %s`,
+		query := fmt.Sprintf(`sum(rate(%s{reporter="source"%s,source_workload_namespace!="%s",destination_workload_namespace="unknown",destination_workload="unknown",destination_service=~"^.+\\.%s\\..+$"} [%vs])) by (%s) %s`,
 			metric,
+			codeFilter,
 			namespace,
 			namespace,
 			int(duration.Seconds()), // range duration for the query
@@ -105,8 +107,9 @@ func buildNamespaceTrafficMap(namespace string, o graph.TelemetryOptions, client
 		populateTrafficMap(trafficMap, &incomingVector, metric, o)
 
 		// 1) Incoming: query destination telemetry to capture namespace services' incoming traffic
-		query = fmt.Sprintf(`sum(rate(%s{reporter="destination",destination_workload_namespace="%s"} [%vs])) by (%s) %s`,
+		query = fmt.Sprintf(`sum(rate(%s{reporter="destination"%s,destination_workload_namespace="%s"} [%vs])) by (%s) %s`,
 			metric,
+			codeFilter,
 			namespace,
 			int(duration.Seconds()), // range duration for the query
 			groupBy,
@@ -115,8 +118,9 @@ func buildNamespaceTrafficMap(namespace string, o graph.TelemetryOptions, client
 		populateTrafficMap(trafficMap, &incomingVector, metric, o)
 
 		// 2) Outgoing: query source telemetry to capture namespace workloads' outgoing traffic
-		query = fmt.Sprintf(`sum(rate(%s{reporter="source",source_workload_namespace="%s"} [%vs])) by (%s) %s`,
+		query = fmt.Sprintf(`sum(rate(%s{reporter="source"%s,source_workload_namespace="%s"} [%vs])) by (%s) %s`,
 			metric,
+			codeFilter,
 			namespace,
 			int(duration.Seconds()), // range duration for the query
 			groupBy,
@@ -313,6 +317,11 @@ func populateTrafficMap(trafficMap graph.TrafficMap, vector *model.Vector, metri
 
 			// set response code in a backward compatible way
 			code = util.HandleResponseCode(protocol, string(lCode), grpcOk, string(lGrpc))
+
+			// the query filter lets GRPC requests with an OK transport code through, their GRPC status decides
+			if o.ResponseCodeClass != "" && util.ResponseCodeClass(protocol, code) != o.ResponseCodeClass {
+				continue
+			}
 		}
 
 		// make code more readable by setting "host" because "destSvc" holds destination.service.host | request.host | "unknown"
@@ -469,6 +478,7 @@ func buildNodeTrafficMap(cluster, namespace string, n graph.Node, o graph.Teleme
 	// HTTP/GRPC Traffic
 	if o.Rates.Http == graph.RateRequests || o.Rates.Grpc == graph.RateRequests {
 		metric := "istio_requests_total"
+		codeFilter := responseCodeFilter(o)
 		groupBy := "source_cluster,source_workload_namespace,source_workload,source_canonical_service,source_canonical_revision,destination_cluster,destination_service_namespace,destination_service,destination_service_name,destination_workload_namespace,destination_workload,destination_canonical_service,destination_canonical_revision,request_protocol,response_code,grpc_response_status,response_flags"
 
 		// query prometheus for request traffic in two queries:
@@ -478,7 +488,7 @@ func buildNodeTrafficMap(cluster, namespace string, n graph.Node, o graph.Teleme
 		case graph.NodeTypeWorkload:
 			query = fmt.Sprintf(`sum(rate(%s{reporter="destination"%s,destination_workload_namespace="%s",destination_workload="%s"} [%vs])) by (%s) %s`,
 				metric,
-				destCluster,
+				destCluster+codeFilter,
 				namespace,
 				n.Workload,
 				int(duration.Seconds()), // range duration for the query
@@ -488,7 +498,7 @@ func buildNodeTrafficMap(cluster, namespace string, n graph.Node, o graph.Teleme
 			if graph.IsOK(n.Version) {
 				query = fmt.Sprintf(`sum(rate(%s{reporter="destination"%s,destination_service_namespace="%s",destination_canonical_service="%s",destination_canonical_revision="%s"} [%vs])) by (%s) %s`,
 					metric,
-					destCluster,
+					destCluster+codeFilter,
 					namespace,
 					n.App,
 					n.Version,
@@ -498,7 +508,7 @@ func buildNodeTrafficMap(cluster, namespace string, n graph.Node, o graph.Teleme
 			} else {
 				query = fmt.Sprintf(`sum(rate(%s{reporter="destination"%s,destination_service_namespace="%s",destination_canonical_service="%s"} [%vs])) by (%s) %s`,
 					metric,
-					destCluster,
+					destCluster+codeFilter,
 					namespace,
 					n.App,
 					int(duration.Seconds()), // range duration for the query
@@ -510,7 +520,7 @@ func buildNodeTrafficMap(cluster, namespace string, n graph.Node, o graph.Teleme
 			// 1.a) query source telemetry for requests to the service that could not be serviced
 			query = fmt.Sprintf(`sum(rate(%s{reporter="source"%s,destination_workload="unknown",destination_service=~"^%s\\.%s\\..*$"} [%vs])) by (%s) %s`,
 				metric,
-				destCluster,
+				destCluster+codeFilter,
 				n.Service,
 				namespace,
 				int(duration.Seconds()), // range duration for the query
@@ -522,7 +532,7 @@ func buildNodeTrafficMap(cluster, namespace string, n graph.Node, o graph.Teleme
 			// 1.b) query dest telemetry for requests to the service, serviced by service workloads
 			query = fmt.Sprintf(`sum(rate(%s{reporter="destination"%s,destination_service_namespace="%s",destination_service=~"^%s\\.%s\\..*$"} [%vs])) by (%s) %s`,
 				metric,
-				destCluster,
+				destCluster+codeFilter,
 				namespace,
 				n.Service,
 				namespace,
@@ -540,7 +550,7 @@ func buildNodeTrafficMap(cluster, namespace string, n graph.Node, o graph.Teleme
 		case graph.NodeTypeWorkload:
 			query = fmt.Sprintf(`sum(rate(%s{reporter="source"%s,source_workload_namespace="%s",source_workload="%s"} [%vs])) by (%s) %s`,
 				metric,
-				sourceCluster,
+				sourceCluster+codeFilter,
 				namespace,
 				n.Workload,
 				int(duration.Seconds()), // range duration for the query
@@ -550,7 +560,7 @@ func buildNodeTrafficMap(cluster, namespace string, n graph.Node, o graph.Teleme
 			if graph.IsOK(n.Version) {
 				query = fmt.Sprintf(`sum(rate(%s{reporter="source"%s,source_workload_namespace="%s",source_canonical_service="%s",source_canonical_revision="%s"} [%vs])) by (%s) %s`,
 					metric,
-					sourceCluster,
+					sourceCluster+codeFilter,
 					namespace,
 					n.App,
 					n.Version,
@@ -560,7 +570,7 @@ func buildNodeTrafficMap(cluster, namespace string, n graph.Node, o graph.Teleme
 			} else {
 				query = fmt.Sprintf(`sum(rate(%s{reporter="source"%s,source_workload_namespace="%s",source_canonical_service="%s"} [%vs])) by (%s) %s`,
 					metric,
-					sourceCluster,
+					sourceCluster+codeFilter,
 					namespace,
 					n.App,
 					int(duration.Seconds()), // range duration for the query
@@ -846,7 +856,7 @@ func buildAggregateNodeTrafficMap(namespace string, n graph.Node, o graph.Teleme
 		namespace,
 		n.Metadata[graph.Aggregate],
 		n.Metadata[graph.AggregateValue],
-		serviceFragment,
+		serviceFragment+responseCodeFilter(o),
 		int(interval.Seconds()), // range duration for the query
 		groupBy)
 	/* It's not clear that request classification makes sense for TCP metrics. Because it costs us queries I'm
@@ -867,6 +877,22 @@ func buildAggregateNodeTrafficMap(namespace string, n graph.Node, o graph.Teleme
 	return trafficMap
 }
 
+// responseCodeFilter returns the response_code label matcher scoping request traffic to the
+// requested response code class, or an empty string when all response codes are requested.
+// GRPC errors are mostly reported with a 200 response_code and a non-zero grpc_response_status,
+// so those requests are also let through when GRPC requests are requested. populateTrafficMap
+// then keeps the ones whose GRPC status belongs to the class.
+func responseCodeFilter(o graph.TelemetryOptions) string {
+	if o.ResponseCodeClass == "" {
+		return ""
+	}
+	codes := o.ResponseCodeClass[:1] + ".."
+	if o.Rates.Grpc == graph.RateRequests && o.ResponseCodeClass != graph.ResponseCodeClass2xx {
+		codes += "|200"
+	}
+	return fmt.Sprintf(`,response_code=~"%s"`, codes)
+}
+
 func promQuery(query string, queryTime time.Time, api prom_v1.API) model.Vector {
 	if query == "" {
 		return model.Vector{}
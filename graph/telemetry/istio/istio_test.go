@@ -0,0 +1,136 @@
+package istio
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/graph"
+	"github.com/kiali/kiali/prometheus"
+	"github.com/kiali/kiali/prometheus/prometheustest"
+)
+
+func TestResponseCodeFilter(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal("", responseCodeFilter(graph.TelemetryOptions{}))
+	assert.Equal(`,response_code=~"5.."`, responseCodeFilter(graph.TelemetryOptions{ResponseCodeClass: graph.ResponseCodeClass5xx}))
+	assert.Equal(`,response_code=~"4.."`, responseCodeFilter(graph.TelemetryOptions{ResponseCodeClass: graph.ResponseCodeClass4xx}))
+
+	grpcRequests := graph.RequestedRates{Grpc: graph.RateRequests, Http: graph.RateRequests}
+	assert.Equal(`,response_code=~"5..|200"`, responseCodeFilter(graph.TelemetryOptions{ResponseCodeClass: graph.ResponseCodeClass5xx, Rates: grpcRequests}))
+	assert.Equal(`,response_code=~"2.."`, responseCodeFilter(graph.TelemetryOptions{ResponseCodeClass: graph.ResponseCodeClass2xx, Rates: grpcRequests}))
+}
+
+func TestPopulateTrafficMapResponseCodeClass(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	sample := func(destWl, protocol, code, grpcStatus string) *model.Sample {
+		return &model.Sample{
+			Metric: model.Metric{
+				"source_cluster":                 "east",
+				"source_workload_namespace":      "bookinfo",
+				"source_workload":                "productpage-v1",
+				"source_canonical_service":       "productpage",
+				"source_canonical_revision":      "v1",
+				"destination_cluster":            "east",
+				"destination_service_namespace":  "bookinfo",
+				"destination_service":            model.LabelValue(destWl + ".bookinfo.svc.cluster.local"),
+				"destination_service_name":       model.LabelValue(destWl),
+				"destination_workload_namespace": "bookinfo",
+				"destination_workload":           model.LabelValue(destWl + "-v1"),
+				"destination_canonical_service":  model.LabelValue(destWl),
+				"destination_canonical_revision": "v1",
+				"request_protocol":               model.LabelValue(protocol),
+				"response_code":                  model.LabelValue(code),
+				"grpc_response_status":           model.LabelValue(grpcStatus),
+				"response_flags":                 "-",
+			},
+			Value: 10,
+		}
+	}
+	vector := model.Vector{
+		sample("reviews", "http", "503", ""),
+		sample("details", "http", "200", ""),
+		sample("ratings", "grpc", "200", "14"),
+		sample("orders", "grpc", "200", "0"),
+	}
+
+	o := graph.TelemetryOptions{
+		Rates:             graph.RequestedRates{Grpc: graph.RateRequests, Http: graph.RateRequests},
+		ResponseCodeClass: graph.ResponseCodeClass5xx,
+	}
+	o.GraphType = graph.GraphTypeWorkload
+	trafficMap := graph.NewTrafficMap()
+	populateTrafficMap(trafficMap, &vector, "istio_requests_total", o)
+
+	destinations := make([]string, 0)
+	for _, n := range trafficMap {
+		for _, e := range n.Edges {
+			destinations = append(destinations, e.Dest.Workload)
+		}
+	}
+	assert.ElementsMatch([]string{"reviews-v1", "ratings-v1"}, destinations)
+}
+
+func TestNamespaceRequestsQueriesResponseCodeClass(t *testing.T) {
+	assert := assert.New(t)
+
+	queries := namespaceQueries(t, graph.ResponseCodeClass5xx)
+	assert.Len(queries, 3)
+	for _, query := range queries {
+		assert.Contains(query, `istio_requests_total{`)
+		assert.Contains(query, `,response_code=~"5.."`)
+	}
+}
+
+func TestNamespaceRequestsQueriesAllResponseCodes(t *testing.T) {
+	assert := assert.New(t)
+
+	queries := namespaceQueries(t, "")
+	assert.Len(queries, 3)
+	for _, query := range queries {
+		assert.NotContains(query, "response_code=~")
+	}
+}
+
+// namespaceQueries returns the prometheus queries issued to build the request traffic of the bookinfo namespace
+func namespaceQueries(t *testing.T, responseCodeClass string) []string {
+	config.Set(config.NewConfig())
+
+	api := new(prometheustest.PromAPIMock)
+	api.On("Query", mock.Anything, mock.AnythingOfType("string"), mock.Anything).Return(model.Vector{})
+
+	client, err := prometheus.NewClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.Inject(api)
+
+	o := graph.TelemetryOptions{
+		Namespaces: graph.NamespaceInfoMap{
+			"bookinfo": graph.NamespaceInfo{Name: "bookinfo", Duration: 10 * time.Minute},
+		},
+		Rates: graph.RequestedRates{
+			Grpc: graph.RateNone,
+			Http: graph.RateRequests,
+			Tcp:  graph.RateNone,
+		},
+		ResponseCodeClass: responseCodeClass,
+	}
+	buildNamespaceTrafficMap("bookinfo", o, client)
+
+	requestQueries := make([]string, 0)
+	for _, call := range api.Calls {
+		if query := call.Arguments.String(1); strings.Contains(query, "istio_requests_total") {
+			requestQueries = append(requestQueries, query)
+		}
+	}
+	return requestQueries
+}
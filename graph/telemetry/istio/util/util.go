@@ -84,6 +84,43 @@ func HandleResponseCode(protocol, responseCode string, grpcResponseStatusOk bool
 	return grpcResponseStatus
 }
 
+// grpcStatusClasses maps the non-OK GRPC status codes to the class of the HTTP status they translate to,
+// following the GRPC to HTTP status mapping of grpc-gateway.
+var grpcStatusClasses = map[string]string{
+	"1":  graph.ResponseCodeClass4xx, // CANCELLED
+	"2":  graph.ResponseCodeClass5xx, // UNKNOWN
+	"3":  graph.ResponseCodeClass4xx, // INVALID_ARGUMENT
+	"4":  graph.ResponseCodeClass5xx, // DEADLINE_EXCEEDED
+	"5":  graph.ResponseCodeClass4xx, // NOT_FOUND
+	"6":  graph.ResponseCodeClass4xx, // ALREADY_EXISTS
+	"7":  graph.ResponseCodeClass4xx, // PERMISSION_DENIED
+	"8":  graph.ResponseCodeClass4xx, // RESOURCE_EXHAUSTED
+	"9":  graph.ResponseCodeClass4xx, // FAILED_PRECONDITION
+	"10": graph.ResponseCodeClass4xx, // ABORTED
+	"11": graph.ResponseCodeClass4xx, // OUT_OF_RANGE
+	"12": graph.ResponseCodeClass5xx, // UNIMPLEMENTED
+	"13": graph.ResponseCodeClass5xx, // INTERNAL
+	"14": graph.ResponseCodeClass5xx, // UNAVAILABLE
+	"15": graph.ResponseCodeClass5xx, // DATA_LOSS
+	"16": graph.ResponseCodeClass4xx, // UNAUTHENTICATED
+}
+
+// ResponseCodeClass returns the response code class (e.g. 5xx) of a code returned by HandleResponseCode.
+// GRPC statuses are classified as the HTTP status they translate to, OK being 2xx.
+// return "" for requests that did not receive a response.
+func ResponseCodeClass(protocol, code string) string {
+	if code == "-" || code == "" {
+		return ""
+	}
+	if protocol == graph.GRPC.Name && len(code) < 3 {
+		if code == "0" {
+			return graph.ResponseCodeClass2xx
+		}
+		return grpcStatusClasses[code]
+	}
+	return code[:1] + "xx"
+}
+
 // IsBadSourceTelemetry tests for known issues in generated telemetry given indicative label values.
 // 1) source namespace is ok but neither workload nor app are set
 // 2) source namespace is ok and source_cluster is provided but not ok.
@@ -53,6 +53,7 @@ type K8SClient struct {
 	k8s                *kube.Clientset
 	istioNetworkingApi *rest.RESTClient
 	istioSecurityApi   *rest.RESTClient
+	istioTelemetryApi  *rest.RESTClient
 	iter8Api           *rest.RESTClient
 	// Used in REST queries after bump to client-go v0.20.x
 	ctx context.Context
@@ -75,6 +76,11 @@ type K8SClient struct {
 	// It is represented as a pointer to include the initialization phase.
 	// See istio_details_service.go#hasSecurityResource() for more details.
 	securityResources *map[string]bool
+
+	// telemetryResources private variable will check which resources kiali has access to from telemetry.istio.io group
+	// It is represented as a pointer to include the initialization phase.
+	// See istio_details_service.go#hasTelemetryResource() for more details.
+	telemetryResources *map[string]bool
 }
 
 // GetK8sApi returns the clientset referencing all K8s rest clients
@@ -196,6 +202,10 @@ func NewClientFromConfig(config *rest.Config) (*K8SClient, error) {
 				scheme.AddKnownTypeWithName(SecurityGroupVersion.WithKind(rt.objectKind), &GenericIstioObject{})
 				scheme.AddKnownTypeWithName(SecurityGroupVersion.WithKind(rt.collectionKind), &GenericIstioObjectList{})
 			}
+			for _, tt := range telemetryTypes {
+				scheme.AddKnownTypeWithName(TelemetryGroupVersion.WithKind(tt.objectKind), &GenericIstioObject{})
+				scheme.AddKnownTypeWithName(TelemetryGroupVersion.WithKind(tt.collectionKind), &GenericIstioObjectList{})
+			}
 			// Register Extension (iter8) types
 			for _, rt := range iter8Types {
 				// We will use a Iter8ExperimentObject which only contains metadata and spec with interfaces
@@ -206,6 +216,7 @@ func NewClientFromConfig(config *rest.Config) (*K8SClient, error) {
 
 			meta_v1.AddToGroupVersion(scheme, NetworkingGroupVersion)
 			meta_v1.AddToGroupVersion(scheme, SecurityGroupVersion)
+			meta_v1.AddToGroupVersion(scheme, TelemetryGroupVersion)
 			meta_v1.AddToGroupVersion(scheme, Iter8GroupVersion)
 			return nil
 		})
@@ -225,6 +236,11 @@ func NewClientFromConfig(config *rest.Config) (*K8SClient, error) {
 		return nil, err
 	}
 
+	istioTelemetryApi, err := newClientForAPI(config, TelemetryGroupVersion, types)
+	if err != nil {
+		return nil, err
+	}
+
 	iter8Api, err := newClientForAPI(config, Iter8GroupVersion, types)
 	if err != nil {
 		return nil, err
@@ -232,6 +248,7 @@ func NewClientFromConfig(config *rest.Config) (*K8SClient, error) {
 
 	client.istioNetworkingApi = istioNetworkingAPI
 	client.istioSecurityApi = istioSecurityApi
+	client.istioTelemetryApi = istioTelemetryApi
 	client.iter8Api = iter8Api
 	client.ctx = context.Background()
 	return &client, nil
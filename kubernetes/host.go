@@ -139,11 +139,7 @@ func HasMatchingServices(service string, services []core_v1.Service) bool {
 
 func HasMatchingServiceEntries(service string, serviceEntries map[string][]string) bool {
 	for k := range serviceEntries {
-		hostKey := k
-		if i := strings.Index(k, "*"); i > -1 {
-			hostKey = k[i+1:]
-		}
-		if strings.HasSuffix(service, hostKey) {
+		if strings.Contains(k, "*") && HostMatchesWildcard(service, k) {
 			return true
 		}
 	}
@@ -227,6 +223,62 @@ func HasMatchingRegistryStatus(host string, registryStatus []*RegistryStatus) bo
 	return false
 }
 
+// MatchRegistryHost returns true when the host, referenced from an object of the namespace param, matches
+// the hostname of one registry entry. Short names and <name>.<namespace> hosts are expanded into their FQDN
+// before matching, while "*" and "*.suffix" hosts match any registry hostname they cover.
+func MatchRegistryHost(host string, namespace string, registry []*RegistryStatus) bool {
+	candidates := []string{host}
+	if strings.HasPrefix(host, "*") {
+		for _, rStatus := range registry {
+			if HostMatchesWildcard(rStatus.Hostname, host) {
+				return true
+			}
+		}
+		return false
+	}
+
+	domain := config.Get().ExternalServices.Istio.IstioIdentityDomain
+	switch parts := strings.Split(host, "."); len(parts) {
+	case 1:
+		candidates = append(candidates, fmt.Sprintf("%s.%s.%s", host, namespace, domain))
+	case 2:
+		candidates = append(candidates, fmt.Sprintf("%s.%s", host, domain))
+	}
+
+	for _, candidate := range candidates {
+		if HasMatchingRegistryStatus(candidate, registry) {
+			return true
+		}
+	}
+	return false
+}
+
+// GatewayServerHostAdmits returns true when a Gateway server host, in the [<namespace>/]<host> form, admits any
+// of the hosts of a VirtualService of vsNamespace bound to a Gateway of gatewayNamespace.
+func GatewayServerHostAdmits(serverHost, gatewayNamespace, vsNamespace string, vsHosts []string) bool {
+	if parts := strings.SplitN(serverHost, "/", 2); len(parts) == 2 {
+		switch parts[0] {
+		case "*":
+		case ".":
+			if gatewayNamespace != vsNamespace {
+				return false
+			}
+		default:
+			if parts[0] != vsNamespace {
+				return false
+			}
+		}
+		serverHost = parts[1]
+	}
+
+	for _, vsHost := range vsHosts {
+		if HostMatchesWildcard(vsHost, serverHost) || HostMatchesWildcard(serverHost, vsHost) {
+			return true
+		}
+	}
+	return false
+}
+
 func HostWithinWildcardHost(subdomain, wildcardDomain string) bool {
 	if !strings.HasPrefix(wildcardDomain, "*") {
 		return false
@@ -235,6 +287,30 @@ func HostWithinWildcardHost(subdomain, wildcardDomain string) bool {
 	return len(wildcardDomain) > 2 && strings.HasSuffix(subdomain, wildcardDomain[2:])
 }
 
+// HostMatchesWildcard returns true when the host matches the wildcardHost pattern,
+// where each "*" matches any sequence of characters, i.e. "*.local", "api-*.prod.svc" or "api.*.svc".
+func HostMatchesWildcard(host, wildcardHost string) bool {
+	parts := strings.Split(wildcardHost, "*")
+	if len(parts) == 1 {
+		return host == wildcardHost
+	}
+
+	last := len(parts) - 1
+	if !strings.HasPrefix(host, parts[0]) || !strings.HasSuffix(host, parts[last]) || len(host) < len(parts[0])+len(parts[last]) {
+		return false
+	}
+
+	remaining := host[len(parts[0]) : len(host)-len(parts[last])]
+	for _, part := range parts[1:last] {
+		i := strings.Index(remaining, part)
+		if i < 0 {
+			return false
+		}
+		remaining = remaining[i+len(part):]
+	}
+	return true
+}
+
 func ParseGatewayAsHost(gateway, currentNamespace, currentCluster string) Host {
 	host := Host{
 		Service:       gateway,
@@ -23,6 +23,29 @@ func TestGatewayAsHost(t *testing.T) {
 	assert.Equal("mygateway.bookinfo.svc.cluster.local", ParseGatewayAsHost("mygateway.bookinfo.svc.cluster.local", "bookinfo", "svc.cluster.local").String())
 }
 
+func TestHostMatchesWildcard(t *testing.T) {
+	assert := assert.New(t)
+
+	// Suffix wildcards
+	assert.True(HostMatchesWildcard("sni-proxy.local", "*.local"))
+	assert.True(HostMatchesWildcard("anything", "*"))
+	assert.False(HostMatchesWildcard("sni-proxy.remote", "*.local"))
+
+	// Prefix wildcards
+	assert.True(HostMatchesWildcard("api-v1.prod.svc.cluster.local", "api-*.prod.svc.cluster.local"))
+	assert.True(HostMatchesWildcard("api-*.prod.svc.cluster.local", "api-*.prod.svc.cluster.local"))
+	assert.False(HostMatchesWildcard("web.prod.svc.cluster.local", "api-*.prod.svc.cluster.local"))
+
+	// Mid-string wildcards
+	assert.True(HostMatchesWildcard("api.eu.example.com", "api.*.example.com"))
+	assert.True(HostMatchesWildcard("api-1.eu.example.com", "api-*.*.example.com"))
+	assert.False(HostMatchesWildcard("api.example.com", "api.*.example.com"))
+
+	// No wildcard
+	assert.True(HostMatchesWildcard("reviews", "reviews"))
+	assert.False(HostMatchesWildcard("reviews", "ratings"))
+}
+
 func TestHasMatchingVirtualServices(t *testing.T) {
 	assert := assert.New(t)
 
@@ -67,3 +90,31 @@ func createVirtualService(namespace string, hosts []string) IstioObject {
 		},
 	}).DeepCopyIstioObject()
 }
+
+func TestMatchRegistryHost(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	registry := []*RegistryStatus{
+		{RegistryService: RegistryService{Hostname: "ratings.mesh2-bookinfo.svc.cluster.local"}},
+		{RegistryService: RegistryService{Hostname: "api.example.com"}},
+	}
+
+	// Short name, expanded with the namespace of the referencing object
+	assert.True(MatchRegistryHost("ratings", "mesh2-bookinfo", registry))
+	assert.False(MatchRegistryHost("ratings", "bookinfo", registry))
+
+	// <name>.<namespace>
+	assert.True(MatchRegistryHost("ratings.mesh2-bookinfo", "bookinfo", registry))
+	assert.False(MatchRegistryHost("reviews.mesh2-bookinfo", "bookinfo", registry))
+
+	// FQDN
+	assert.True(MatchRegistryHost("ratings.mesh2-bookinfo.svc.cluster.local", "bookinfo", registry))
+	assert.True(MatchRegistryHost("api.example.com", "bookinfo", registry))
+	assert.False(MatchRegistryHost("ratings.bookinfo.svc.cluster.local", "bookinfo", registry))
+
+	// *.suffix
+	assert.True(MatchRegistryHost("*.example.com", "bookinfo", registry))
+	assert.True(MatchRegistryHost("*.svc.cluster.local", "bookinfo", registry))
+	assert.False(MatchRegistryHost("*.example.org", "bookinfo", registry))
+}
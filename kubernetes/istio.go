@@ -19,6 +19,7 @@ import (
 	"github.com/kiali/kiali/config"
 	"github.com/kiali/kiali/log"
 	"github.com/kiali/kiali/util/httputil"
+	"github.com/kiali/kiali/util/intutil"
 )
 
 var (
@@ -43,6 +44,8 @@ func (in *K8SClient) getApiClientVersion(apiGroup string) (*rest.RESTClient, str
 		return in.istioNetworkingApi, ApiNetworkingVersion
 	} else if apiGroup == SecurityGroupVersion.Group {
 		return in.istioSecurityApi, ApiSecurityVersion
+	} else if apiGroup == TelemetryGroupVersion.Group {
+		return in.istioTelemetryApi, ApiTelemetryVersion
 	}
 	return nil, ""
 }
@@ -137,6 +140,10 @@ func (in *K8SClient) GetIstioObjects(namespace, resourceType, labelSelector stri
 		return []IstioObject{}, nil
 	}
 
+	if apiGroup == TelemetryGroupVersion.Group && !in.hasTelemetryResource(resourceType) {
+		return []IstioObject{}, nil
+	}
+
 	var result runtime.Object
 	var err error
 	result, err = apiClient.Get().Namespace(namespace).Resource(resourceType).Param("labelSelector", labelSelector).Do(in.ctx).Get()
@@ -391,6 +398,31 @@ func (in *K8SClient) getSecurityResources() map[string]bool {
 	return *in.securityResources
 }
 
+func (in *K8SClient) hasTelemetryResource(resource string) bool {
+	return in.getTelemetryResources()[resource]
+}
+
+func (in *K8SClient) getTelemetryResources() map[string]bool {
+	if in.telemetryResources != nil {
+		return *in.telemetryResources
+	}
+
+	telemetryResources := map[string]bool{}
+	path := fmt.Sprintf("/apis/%s", ApiTelemetryVersion)
+	resourceListRaw, err := in.k8s.RESTClient().Get().AbsPath(path).Do(in.ctx).Raw()
+	if err == nil {
+		resourceList := meta_v1.APIResourceList{}
+		if errMarshall := json.Unmarshal(resourceListRaw, &resourceList); errMarshall == nil {
+			for _, resource := range resourceList.APIResources {
+				telemetryResources[resource.Name] = true
+			}
+		}
+	}
+	in.telemetryResources = &telemetryResources
+
+	return *in.telemetryResources
+}
+
 func GetIstioConfigMap(istioConfig *core_v1.ConfigMap) (*IstioMeshConfig, error) {
 	meshConfig := &IstioMeshConfig{}
 
@@ -481,6 +513,18 @@ func ValidatePort(portDef interface{}) bool {
 	return MatchPortNameRule(parsePort(portDef))
 }
 
+// ValidatePortNumber returns false when the port definition has a number outside of the 1-65535 range.
+// Port definitions without number are not considered by this validation.
+func ValidatePortNumber(portDef interface{}) bool {
+	if port, ok := portDef.(map[string]interface{}); ok {
+		if numberDef, found := port["number"]; found {
+			number, err := intutil.Convert(numberDef)
+			return err == nil && number >= 1 && number <= 65535
+		}
+	}
+	return true
+}
+
 func parsePort(portDef interface{}) (string, string) {
 	var name, proto string
 	if port, ok := portDef.(map[string]interface{}); ok {
@@ -533,6 +577,137 @@ func MatchPortNameWithValidProtocols(portName string) bool {
 	return false
 }
 
+// ServicePortProtocol returns the protocol Istio selects for a Service port, either from its appProtocol or
+// from its <protocol>[-suffix] name. An empty string is returned when the protocol is left to auto-detection.
+func ServicePortProtocol(port core_v1.ServicePort) string {
+	if port.AppProtocol != nil && *port.AppProtocol != "" {
+		return strings.ToLower(*port.AppProtocol)
+	}
+
+	protocol := ""
+	portName := strings.ToLower(port.Name)
+	for _, p := range portProtocols {
+		if (portName == p || strings.HasPrefix(portName, p+"-")) && len(p) > len(protocol) {
+			protocol = p
+		}
+	}
+	return protocol
+}
+
+// ResolveDelegatedHTTPRoutes returns the effective HTTP routes of a VirtualService, replacing every route
+// that delegates to another VirtualService with the routes of the delegate.
+// The match conditions of the delegating route are merged into each delegate route match.
+// Only one level of delegation is resolved, as Istio doesn't support nested delegates.
+// Delegates not found in the given list are kept unresolved.
+func ResolveDelegatedHTTPRoutes(virtualService IstioObject, virtualServices []IstioObject) []interface{} {
+	effectiveRoutes := make([]interface{}, 0)
+
+	httpRoutes, ok := virtualService.GetSpec()["http"].([]interface{})
+	if !ok {
+		return effectiveRoutes
+	}
+
+	for _, httpRoute := range httpRoutes {
+		route, ok := httpRoute.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		delegate := FindDelegate(route, virtualService.GetObjectMeta().Namespace, virtualServices)
+		if delegate == nil {
+			effectiveRoutes = append(effectiveRoutes, route)
+			continue
+		}
+		if delegateRoutes, ok := delegate.GetSpec()["http"].([]interface{}); ok {
+			for _, delegateRoute := range delegateRoutes {
+				if delegateRouteCasted, ok := delegateRoute.(map[string]interface{}); ok {
+					effectiveRoutes = append(effectiveRoutes, mergeDelegateRoute(route, delegateRouteCasted))
+				}
+			}
+		}
+	}
+
+	return effectiveRoutes
+}
+
+// FindDelegate returns the VirtualService the http route delegates to, defaulting to the namespace of the
+// delegating VirtualService. It returns nil when the route doesn't delegate or the delegate is not found.
+func FindDelegate(route map[string]interface{}, namespace string, virtualServices []IstioObject) IstioObject {
+	delegate, ok := route["delegate"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	name, _ := delegate["name"].(string)
+	if delegateNs, ok := delegate["namespace"].(string); ok && delegateNs != "" {
+		namespace = delegateNs
+	}
+	for _, vs := range virtualServices {
+		if vs.GetObjectMeta().Name == name && vs.GetObjectMeta().Namespace == namespace {
+			return vs
+		}
+	}
+	return nil
+}
+
+// mergeDelegateRoute builds the effective route of a delegate route reached through a delegating route.
+// Fields of the delegate route take precedence, except for match, which is the combination of both.
+func mergeDelegateRoute(route, delegateRoute map[string]interface{}) map[string]interface{} {
+	merged := make(map[string]interface{}, len(route)+len(delegateRoute))
+	for k, v := range route {
+		if k != "delegate" && k != "match" {
+			merged[k] = v
+		}
+	}
+	for k, v := range delegateRoute {
+		if k != "match" {
+			merged[k] = v
+		}
+	}
+
+	routeMatches, _ := route["match"].([]interface{})
+	delegateMatches, _ := delegateRoute["match"].([]interface{})
+	switch {
+	case len(routeMatches) == 0 && len(delegateMatches) == 0:
+	case len(routeMatches) == 0:
+		merged["match"] = delegateMatches
+	case len(delegateMatches) == 0:
+		merged["match"] = routeMatches
+	default:
+		matches := make([]interface{}, 0, len(routeMatches)*len(delegateMatches))
+		for _, routeMatch := range routeMatches {
+			for _, delegateMatch := range delegateMatches {
+				match := make(map[string]interface{})
+				if routeMatchCasted, ok := routeMatch.(map[string]interface{}); ok {
+					for k, v := range routeMatchCasted {
+						match[k] = v
+					}
+				}
+				if delegateMatchCasted, ok := delegateMatch.(map[string]interface{}); ok {
+					for k, v := range delegateMatchCasted {
+						match[k] = v
+					}
+				}
+				matches = append(matches, match)
+			}
+		}
+		merged["match"] = matches
+	}
+
+	return merged
+}
+
+// ExportTo returns the exportTo values of the object spec. An empty list means the object is exported everywhere.
+func ExportTo(istioObject IstioObject) []string {
+	values := make([]string, 0)
+	if exportTo, ok := istioObject.GetSpec()["exportTo"].([]interface{}); ok {
+		for _, value := range exportTo {
+			if valueStr, ok := value.(string); ok {
+				values = append(values, valueStr)
+			}
+		}
+	}
+	return values
+}
+
 // GatewayNames extracts the gateway names for easier matching
 func GatewayNames(gateways [][]IstioObject) map[string]struct{} {
 	var empty struct{}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	core_v1 "k8s.io/api/core/v1"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/kiali/kiali/config"
@@ -63,6 +64,15 @@ func TestTCPAndUDPMatcher(t *testing.T) {
 	assert.True(t, MatchPortNameRule("everythingisvalid", "TCP"))
 }
 
+func TestValidatePortNumber(t *testing.T) {
+	assert.True(t, ValidatePortNumber(map[string]interface{}{"number": uint32(8443)}))
+	assert.True(t, ValidatePortNumber(map[string]interface{}{"number": float64(65535)}))
+	assert.True(t, ValidatePortNumber(map[string]interface{}{"name": "http"}))
+	assert.False(t, ValidatePortNumber(map[string]interface{}{"number": uint32(0)}))
+	assert.False(t, ValidatePortNumber(map[string]interface{}{"number": uint32(70000)}))
+	assert.False(t, ValidatePortNumber(map[string]interface{}{"number": "http"}))
+}
+
 func TestValidProtocolNameMatcher(t *testing.T) {
 	assert.True(t, MatchPortNameRule("http-name", "http"))
 	assert.True(t, MatchPortNameRule("http2-name", "http2"))
@@ -137,3 +147,86 @@ func createPeerAuthn(name, namespace string, mtls interface{}) IstioObject {
 		},
 	}).DeepCopyIstioObject()
 }
+
+func TestServicePortProtocol(t *testing.T) {
+	assert := assert.New(t)
+
+	appProtocol := "HTTP2"
+	assert.Equal("http2", ServicePortProtocol(core_v1.ServicePort{Name: "tcp-web", AppProtocol: &appProtocol}))
+	assert.Equal("http", ServicePortProtocol(core_v1.ServicePort{Name: "http"}))
+	assert.Equal("http2", ServicePortProtocol(core_v1.ServicePort{Name: "http2-web"}))
+	assert.Equal("tcp", ServicePortProtocol(core_v1.ServicePort{Name: "tcp-db"}))
+	assert.Equal("", ServicePortProtocol(core_v1.ServicePort{Name: "web"}))
+	assert.Equal("", ServicePortProtocol(core_v1.ServicePort{Name: "httpweb"}))
+}
+
+func TestResolveDelegatedHTTPRoutes(t *testing.T) {
+	assert := assert.New(t)
+
+	root := createHTTPVirtualService("bookinfo-root", "istio-system", []interface{}{
+		map[string]interface{}{
+			"match": []interface{}{
+				map[string]interface{}{"uri": map[string]interface{}{"prefix": "/reviews"}},
+			},
+			"delegate": map[string]interface{}{"name": "reviews", "namespace": "bookinfo"},
+		},
+		map[string]interface{}{
+			"route": []interface{}{
+				map[string]interface{}{"destination": map[string]interface{}{"host": "productpage.bookinfo.svc.cluster.local"}},
+			},
+		},
+	})
+	delegate := createHTTPVirtualService("reviews", "bookinfo", []interface{}{
+		map[string]interface{}{
+			"match": []interface{}{
+				map[string]interface{}{"headers": map[string]interface{}{"end-user": map[string]interface{}{"exact": "jason"}}},
+			},
+			"route": []interface{}{
+				map[string]interface{}{"destination": map[string]interface{}{"host": "reviews", "subset": "v2"}},
+			},
+		},
+		map[string]interface{}{
+			"route": []interface{}{
+				map[string]interface{}{"destination": map[string]interface{}{"host": "reviews", "subset": "v1"}},
+			},
+		},
+	})
+
+	routes := ResolveDelegatedHTTPRoutes(root, []IstioObject{root, delegate})
+	assert.Len(routes, 3)
+
+	jasonRoute := routes[0].(map[string]interface{})
+	assert.NotContains(jasonRoute, "delegate")
+	assert.Equal([]interface{}{
+		map[string]interface{}{
+			"uri":     map[string]interface{}{"prefix": "/reviews"},
+			"headers": map[string]interface{}{"end-user": map[string]interface{}{"exact": "jason"}},
+		},
+	}, jasonRoute["match"])
+	assert.Equal(delegate.GetSpec()["http"].([]interface{})[0].(map[string]interface{})["route"], jasonRoute["route"])
+
+	defaultRoute := routes[1].(map[string]interface{})
+	assert.Equal([]interface{}{
+		map[string]interface{}{"uri": map[string]interface{}{"prefix": "/reviews"}},
+	}, defaultRoute["match"])
+	assert.Equal(delegate.GetSpec()["http"].([]interface{})[1].(map[string]interface{})["route"], defaultRoute["route"])
+
+	assert.Equal(root.GetSpec()["http"].([]interface{})[1], routes[2])
+
+	// Delegates not found are left unresolved
+	routes = ResolveDelegatedHTTPRoutes(root, []IstioObject{root})
+	assert.Len(routes, 2)
+	assert.Contains(routes[0], "delegate")
+}
+
+func createHTTPVirtualService(name, namespace string, http []interface{}) IstioObject {
+	return (&GenericIstioObject{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: map[string]interface{}{
+			"http": http,
+		},
+	}).DeepCopyIstioObject()
+}
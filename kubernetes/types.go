@@ -72,6 +72,11 @@ const (
 	RequestAuthenticationsType     = "RequestAuthentication"
 	RequestAuthenticationsTypeList = "RequestAuthenticationList"
 
+	// Telemetry
+	Telemetries       = "telemetries"
+	TelemetryType     = "Telemetry"
+	TelemetryTypeList = "TelemetryList"
+
 	// Iter8 types
 
 	Iter8Experiments        = "experiments"
@@ -93,6 +98,12 @@ var (
 	}
 	ApiSecurityVersion = SecurityGroupVersion.Group + "/" + SecurityGroupVersion.Version
 
+	TelemetryGroupVersion = schema.GroupVersion{
+		Group:   "telemetry.istio.io",
+		Version: "v1alpha1",
+	}
+	ApiTelemetryVersion = TelemetryGroupVersion.Group + "/" + TelemetryGroupVersion.Version
+
 	// We will add a new extesion API in a similar way as we added the Kubernetes + Istio APIs
 	Iter8GroupVersion = schema.GroupVersion{
 		Group:   "iter8.tools",
@@ -156,6 +167,16 @@ var (
 		},
 	}
 
+	telemetryTypes = []struct {
+		objectKind     string
+		collectionKind string
+	}{
+		{
+			objectKind:     TelemetryType,
+			collectionKind: TelemetryTypeList,
+		},
+	}
+
 	iter8Types = []struct {
 		objectKind     string
 		collectionKind string
@@ -183,6 +204,9 @@ var (
 		PeerAuthentications:    PeerAuthenticationsType,
 		RequestAuthentications: RequestAuthenticationsType,
 
+		// Telemetry
+		Telemetries: TelemetryType,
+
 		// Iter8
 		Iter8Experiments: Iter8ExperimentType,
 	}
@@ -199,6 +223,7 @@ var (
 		AuthorizationPolicies:  SecurityGroupVersion.Group,
 		PeerAuthentications:    SecurityGroupVersion.Group,
 		RequestAuthentications: SecurityGroupVersion.Group,
+		Telemetries:            TelemetryGroupVersion.Group,
 		// Extensions
 		Iter8Experiments: Iter8GroupVersion.Group,
 	}
@@ -206,6 +231,7 @@ var (
 	ApiToVersion = map[string]string{
 		NetworkingGroupVersion.Group: ApiNetworkingVersion,
 		SecurityGroupVersion.Group:   ApiSecurityVersion,
+		TelemetryGroupVersion.Group:  ApiTelemetryVersion,
 	}
 )
 
@@ -234,8 +260,14 @@ type IstioObjectList interface {
 }
 
 type IstioMeshConfig struct {
-	DisableMixerHttpReports bool  `yaml:"disableMixerHttpReports,omitempty"`
-	EnableAutoMtls          *bool `yaml:"enableAutoMtls,omitempty"`
+	DisableMixerHttpReports bool                `yaml:"disableMixerHttpReports,omitempty"`
+	EnableAutoMtls          *bool               `yaml:"enableAutoMtls,omitempty"`
+	ExtensionProviders      []ExtensionProvider `yaml:"extensionProviders,omitempty"`
+}
+
+// ExtensionProvider is an external provider defined in the mesh config, i.e. for CUSTOM authorization
+type ExtensionProvider struct {
+	Name string `yaml:"name"`
 }
 
 // IstioDetails is a wrapper to group all Istio objects related to a Service.
@@ -247,6 +279,9 @@ type IstioDetails struct {
 	Gateways               []IstioObject `json:"gateways"`
 	Sidecars               []IstioObject `json:"sidecars"`
 	RequestAuthentications []IstioObject `json:"requestauthentications"`
+	EnvoyFilters           []IstioObject `json:"envoyfilters"`
+	WorkloadEntries        []IstioObject `json:"workloadentries"`
+	Telemetries            []IstioObject `json:"telemetries"`
 }
 
 // MTLSDetails is a wrapper to group all Istio objects related to non-local mTLS configurations
@@ -260,6 +295,8 @@ type MTLSDetails struct {
 // RBACDetails is a wrapper for objects related to Istio RBAC (Role Based Access Control)
 type RBACDetails struct {
 	AuthorizationPolicies []IstioObject `json:"authorizationpolicies"`
+	// ExtensionProviders are the names of the mesh config extension providers, nil when they are unknown
+	ExtensionProviders []string `json:"extensionproviders"`
 }
 
 // GenericIstioObject is a type to test Istio types defined by Istio as a Kubernetes extension.
@@ -462,6 +499,15 @@ func (in *GenericIstioObjectList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// GetExtensionProviderNames returns the names of the extension providers defined in the mesh config
+func (imc IstioMeshConfig) GetExtensionProviderNames() []string {
+	names := make([]string, 0, len(imc.ExtensionProviders))
+	for _, provider := range imc.ExtensionProviders {
+		names = append(names, provider.Name)
+	}
+	return names
+}
+
 func (imc IstioMeshConfig) GetEnableAutoMtls() bool {
 	if imc.EnableAutoMtls == nil {
 		return true
@@ -41,3 +41,8 @@ func (ap *AuthorizationPolicy) Parse(authorizationPolicy kubernetes.IstioObject)
 	ap.Spec.Rules = authorizationPolicy.GetSpec()["rules"]
 	ap.Spec.Action = authorizationPolicy.GetSpec()["action"]
 }
+
+// ContentHash returns a hash of the AuthorizationPolicy spec. Identical specs produce identical hashes.
+func (ap *AuthorizationPolicy) ContentHash() string {
+	return contentHash(ap.Spec)
+}
@@ -3,6 +3,7 @@ package models
 import (
 	"github.com/kiali/kiali/config"
 	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/util/intutil"
 )
 
 // DestinationRules destinationRules
@@ -32,6 +33,26 @@ type DestinationRule struct {
 	} `json:"spec"`
 }
 
+// ConnectionPoolLimits summarizes the connection pool limits of a DestinationRule traffic policy
+type ConnectionPoolLimits struct {
+	MaxConnections          int `json:"maxConnections"`
+	HTTP1MaxPendingRequests int `json:"http1MaxPendingRequests"`
+	HTTP2MaxRequests        int `json:"http2MaxRequests"`
+}
+
+// SubsetReachability classifies the subsets of a host by whether DestinationRules define them
+// and VirtualServices route to them
+type SubsetReachability struct {
+	// Subsets defined and routed to
+	Reachable []string `json:"reachable"`
+
+	// Subsets defined but never routed to
+	Unused []string `json:"unused"`
+
+	// Subsets routed to but not defined
+	Undefined []string `json:"undefined"`
+}
+
 func (dRules *DestinationRules) Parse(destinationRules []kubernetes.IstioObject) {
 	dRules.Items = []DestinationRule{}
 	for _, dr := range destinationRules {
@@ -49,6 +70,30 @@ func (dRule *DestinationRule) Parse(destinationRule kubernetes.IstioObject) {
 	dRule.Spec.ExportTo = destinationRule.GetSpec()["exportTo"]
 }
 
+// ContentHash returns a hash of the DestinationRule spec. Identical specs produce identical hashes.
+func (dRule *DestinationRule) ContentHash() string {
+	return contentHash(dRule.Spec)
+}
+
+// DefinesCircuitBreaker determines if the spec has outlierDetection or connectionPool set,
+// either in the top level trafficPolicy or in the trafficPolicy of any subset, whatever the host.
+func (dRule *DestinationRule) DefinesCircuitBreaker() bool {
+	if dRule == nil {
+		return false
+	}
+	if isCircuitBreakerTrafficPolicy(dRule.Spec.TrafficPolicy) {
+		return true
+	}
+	if subsets, ok := dRule.Spec.Subsets.([]interface{}); ok {
+		for _, subsetInterface := range subsets {
+			if subset, ok := subsetInterface.(map[string]interface{}); ok && isCircuitBreakerTrafficPolicy(subset["trafficPolicy"]) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func (dRule *DestinationRule) HasCircuitBreaker(namespace string, serviceName string, version string) bool {
 	if host, ok := dRule.Spec.Host.(string); ok && kubernetes.FilterByHost(host, serviceName, namespace) {
 		// CB is set at DR level, so it's true for the service and all versions
@@ -79,6 +124,140 @@ func (dRule *DestinationRule) HasCircuitBreaker(namespace string, serviceName st
 	return false
 }
 
+// HasLoadBalancer determines if a loadBalancer is set in the trafficPolicy of the given subset or,
+// when the subset is empty or doesn't set one, in the top level trafficPolicy.
+func (dRule *DestinationRule) HasLoadBalancer(subset string) bool {
+	_, found := dRule.LoadBalancer(subset)
+	return found
+}
+
+// LoadBalancer returns the loadBalancer settings applied to the given subset, or to the whole host when the
+// subset is empty. Subset settings take precedence over the top level ones.
+func (dRule *DestinationRule) LoadBalancer(subset string) (map[string]interface{}, bool) {
+	return dRule.trafficPolicySetting(subset, "loadBalancer")
+}
+
+// SubsetLoadBalancer returns the loadBalancer settings set in the trafficPolicy of the given subset itself,
+// without falling back to the top level ones.
+func (dRule *DestinationRule) SubsetLoadBalancer(subset string) (map[string]interface{}, bool) {
+	return dRule.subsetTrafficPolicySetting(subset, "loadBalancer")
+}
+
+// LoadBalancerType returns the load balancing algorithm applied to the given subset, or to the whole host when
+// the subset is empty, i.e. "ROUND_ROBIN", "LEAST_REQUEST", "RING_HASH" or "MAGLEV".
+// Subset settings take precedence over the top level ones. It returns "" when no algorithm is set.
+func (dRule *DestinationRule) LoadBalancerType(subset string) string {
+	loadBalancer, found := dRule.LoadBalancer(subset)
+	if !found {
+		return ""
+	}
+	if simple, ok := loadBalancer["simple"].(string); ok {
+		return simple
+	}
+	if consistentHash, ok := loadBalancer["consistentHash"].(map[string]interface{}); ok {
+		// Istio uses a ring hash unless maglev is set
+		if _, ok := consistentHash["maglev"]; ok {
+			return "MAGLEV"
+		}
+		return "RING_HASH"
+	}
+	return ""
+}
+
+// HasTLSSettings determines if tls settings are set in the trafficPolicy of the given subset or,
+// when the subset is empty or doesn't set them, in the top level trafficPolicy.
+func (dRule *DestinationRule) HasTLSSettings(subset string) bool {
+	_, found := dRule.trafficPolicySetting(subset, "tls")
+	return found
+}
+
+// TLSMode returns the tls mode applied to the given subset, or to the whole host when the subset is empty,
+// i.e. "DISABLE", "SIMPLE", "MUTUAL" or "ISTIO_MUTUAL". Subset settings take precedence over the top level ones.
+// It returns "" when no tls mode is set.
+func (dRule *DestinationRule) TLSMode(subset string) string {
+	tls, found := dRule.trafficPolicySetting(subset, "tls")
+	if !found {
+		return ""
+	}
+	mode, _ := tls["mode"].(string)
+	return mode
+}
+
+// HasConnectionPool determines if a connectionPool is set in the top level trafficPolicy or in any subset trafficPolicy.
+func (dRule *DestinationRule) HasConnectionPool() bool {
+	if dRule == nil {
+		return false
+	}
+	if _, found := getTrafficPolicySetting(dRule.Spec.TrafficPolicy, "connectionPool"); found {
+		return true
+	}
+	if subsets, ok := dRule.Spec.Subsets.([]interface{}); ok {
+		for _, subsetInterface := range subsets {
+			if subsetDef, ok := subsetInterface.(map[string]interface{}); ok {
+				if _, found := getTrafficPolicySetting(subsetDef["trafficPolicy"], "connectionPool"); found {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// ConnectionPoolSettings returns the connection pool limits applied to the given subset, or to the whole host
+// when the subset is empty. Subset settings take precedence over the top level ones. Unset limits are zero.
+func (dRule *DestinationRule) ConnectionPoolSettings(subset string) ConnectionPoolLimits {
+	limits := ConnectionPoolLimits{}
+	connectionPool, found := dRule.trafficPolicySetting(subset, "connectionPool")
+	if !found {
+		return limits
+	}
+	if tcp, ok := connectionPool["tcp"].(map[string]interface{}); ok {
+		limits.MaxConnections, _ = intutil.Convert(tcp["maxConnections"])
+	}
+	if http, ok := connectionPool["http"].(map[string]interface{}); ok {
+		limits.HTTP1MaxPendingRequests, _ = intutil.Convert(http["http1MaxPendingRequests"])
+		limits.HTTP2MaxRequests, _ = intutil.Convert(http["http2MaxRequests"])
+	}
+	return limits
+}
+
+// trafficPolicySetting returns the setting of the subset trafficPolicy, falling back to the top level one
+func (dRule *DestinationRule) trafficPolicySetting(subset, setting string) (map[string]interface{}, bool) {
+	if dRule == nil {
+		return nil, false
+	}
+	if value, found := dRule.subsetTrafficPolicySetting(subset, setting); found {
+		return value, true
+	}
+	return getTrafficPolicySetting(dRule.Spec.TrafficPolicy, setting)
+}
+
+// subsetTrafficPolicySetting returns the setting of the subset trafficPolicy only
+func (dRule *DestinationRule) subsetTrafficPolicySetting(subset, setting string) (map[string]interface{}, bool) {
+	if dRule == nil || subset == "" {
+		return nil, false
+	}
+	if subsets, ok := dRule.Spec.Subsets.([]interface{}); ok {
+		for _, subsetInterface := range subsets {
+			if subsetDef, ok := subsetInterface.(map[string]interface{}); ok && subsetDef["name"] == subset {
+				if value, found := getTrafficPolicySetting(subsetDef["trafficPolicy"], setting); found {
+					return value, true
+				}
+			}
+		}
+	}
+	return nil, false
+}
+
+func getTrafficPolicySetting(trafficPolicy interface{}, setting string) (map[string]interface{}, bool) {
+	if dTrafficPolicy, ok := trafficPolicy.(map[string]interface{}); ok {
+		if value, ok := dTrafficPolicy[setting].(map[string]interface{}); ok {
+			return value, true
+		}
+	}
+	return nil, false
+}
+
 func isCircuitBreakerTrafficPolicy(trafficPolicy interface{}) bool {
 	if trafficPolicy == nil {
 		return false
@@ -93,3 +272,40 @@ func isCircuitBreakerTrafficPolicy(trafficPolicy interface{}) bool {
 	}
 	return false
 }
+
+// IsExportedTo returns true when an object living in ownerNamespace with the given exportTo values
+// is visible from namespace. An empty exportTo makes the object visible everywhere.
+func IsExportedTo(exportTo []string, ownerNamespace, namespace string) bool {
+	if len(exportTo) == 0 {
+		return true
+	}
+	for _, value := range exportTo {
+		switch value {
+		case "*":
+			return true
+		case ".":
+			if namespace == ownerNamespace {
+				return true
+			}
+		case "~":
+			continue
+		default:
+			if value == namespace {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ExportedBeyond returns the namespaces, out of the given ones, importing a DestinationRule
+// but not the service it targets
+func ExportedBeyond(drExportTo []string, drNamespace string, svcExportTo []string, svcNamespace string, namespaces []string) []string {
+	beyond := make([]string, 0)
+	for _, namespace := range namespaces {
+		if IsExportedTo(drExportTo, drNamespace, namespace) && !IsExportedTo(svcExportTo, svcNamespace, namespace) {
+			beyond = append(beyond, namespace)
+		}
+	}
+	return beyond
+}
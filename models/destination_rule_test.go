@@ -0,0 +1,454 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/yaml"
+
+	"github.com/kiali/kiali/models"
+)
+
+func TestDestinationRuleDefinesCircuitBreaker(t *testing.T) {
+	assert := assert.New(t)
+
+	cases := map[string]struct {
+		drYAML   string
+		expected bool
+	}{
+		"top level outlierDetection": {
+			drYAML: `
+apiVersion: networking.istio.io/v1beta1
+kind: DestinationRule
+metadata:
+  name: reviews
+spec:
+  host: reviews
+  trafficPolicy:
+    outlierDetection:
+      consecutive5xxErrors: 7
+      interval: 5m
+  subsets:
+  - name: v1
+    labels:
+      version: v1
+`,
+			expected: true,
+		},
+		"subset only connectionPool": {
+			drYAML: `
+apiVersion: networking.istio.io/v1beta1
+kind: DestinationRule
+metadata:
+  name: reviews
+spec:
+  host: reviews
+  trafficPolicy: {}
+  subsets:
+  - name: v1
+    labels:
+      version: v1
+  - name: v2
+    labels:
+      version: v2
+    trafficPolicy:
+      connectionPool:
+        tcp:
+          maxConnections: 100
+`,
+			expected: true,
+		},
+		"none": {
+			drYAML: `
+apiVersion: networking.istio.io/v1beta1
+kind: DestinationRule
+metadata:
+  name: reviews
+spec:
+  host: reviews
+  trafficPolicy:
+    loadBalancer:
+      simple: ROUND_ROBIN
+  subsets:
+  - name: v1
+    labels:
+      version: v1
+    trafficPolicy:
+      tls:
+        mode: ISTIO_MUTUAL
+`,
+			expected: false,
+		},
+	}
+
+	for name, c := range cases {
+		var dr models.DestinationRule
+		assert.NoError(yaml.Unmarshal([]byte(c.drYAML), &dr), name)
+		assert.Equal(c.expected, dr.DefinesCircuitBreaker(), name)
+	}
+
+	// Testing nil case
+	var nilDR *models.DestinationRule
+	assert.False(nilDR.DefinesCircuitBreaker())
+}
+
+func TestDestinationRuleLoadBalancer(t *testing.T) {
+	assert := assert.New(t)
+
+	cases := map[string]struct {
+		drYAML       string
+		subset       string
+		expected     bool
+		expectedType string
+		expectedOwn  bool
+	}{
+		"simple": {
+			drYAML: `
+apiVersion: networking.istio.io/v1beta1
+kind: DestinationRule
+metadata:
+  name: reviews
+spec:
+  host: reviews
+  trafficPolicy:
+    loadBalancer:
+      simple: LEAST_REQUEST
+`,
+			expected:     true,
+			expectedType: "LEAST_REQUEST",
+		},
+		"consistentHash": {
+			drYAML: `
+apiVersion: networking.istio.io/v1beta1
+kind: DestinationRule
+metadata:
+  name: reviews
+spec:
+  host: reviews
+  trafficPolicy:
+    loadBalancer:
+      consistentHash:
+        httpHeaderName: x-user
+`,
+			expected:     true,
+			expectedType: "RING_HASH",
+		},
+		"subset preferred": {
+			drYAML: `
+apiVersion: networking.istio.io/v1beta1
+kind: DestinationRule
+metadata:
+  name: reviews
+spec:
+  host: reviews
+  trafficPolicy:
+    loadBalancer:
+      simple: ROUND_ROBIN
+  subsets:
+  - name: v1
+    labels:
+      version: v1
+    trafficPolicy:
+      loadBalancer:
+        consistentHash:
+          maglev:
+            tableSize: 65537
+`,
+			subset:       "v1",
+			expected:     true,
+			expectedType: "MAGLEV",
+			expectedOwn:  true,
+		},
+		"subset inherits top level": {
+			drYAML: `
+apiVersion: networking.istio.io/v1beta1
+kind: DestinationRule
+metadata:
+  name: reviews
+spec:
+  host: reviews
+  trafficPolicy:
+    loadBalancer:
+      simple: ROUND_ROBIN
+  subsets:
+  - name: v1
+    labels:
+      version: v1
+`,
+			subset:       "v1",
+			expected:     true,
+			expectedType: "ROUND_ROBIN",
+		},
+		"absent": {
+			drYAML: `
+apiVersion: networking.istio.io/v1beta1
+kind: DestinationRule
+metadata:
+  name: reviews
+spec:
+  host: reviews
+  trafficPolicy:
+    tls:
+      mode: ISTIO_MUTUAL
+  subsets:
+  - name: v1
+    labels:
+      version: v1
+    trafficPolicy:
+      loadBalancer:
+        simple: RANDOM
+`,
+			expected:     false,
+			expectedType: "",
+		},
+	}
+
+	for name, c := range cases {
+		var dr models.DestinationRule
+		assert.NoError(yaml.Unmarshal([]byte(c.drYAML), &dr), name)
+		assert.Equal(c.expected, dr.HasLoadBalancer(c.subset), name)
+		assert.Equal(c.expectedType, dr.LoadBalancerType(c.subset), name)
+		_, own := dr.SubsetLoadBalancer(c.subset)
+		assert.Equal(c.expectedOwn, own, name)
+	}
+
+	// Testing nil case
+	var nilDR *models.DestinationRule
+	assert.False(nilDR.HasLoadBalancer(""))
+	assert.Equal("", nilDR.LoadBalancerType(""))
+	_, found := nilDR.LoadBalancer("")
+	assert.False(found)
+}
+
+func TestDestinationRuleTLSSettings(t *testing.T) {
+	assert := assert.New(t)
+
+	cases := map[string]struct {
+		drYAML       string
+		subset       string
+		expected     bool
+		expectedMode string
+	}{
+		"top level ISTIO_MUTUAL": {
+			drYAML: `
+apiVersion: networking.istio.io/v1beta1
+kind: DestinationRule
+metadata:
+  name: reviews
+spec:
+  host: reviews
+  trafficPolicy:
+    tls:
+      mode: ISTIO_MUTUAL
+  subsets:
+  - name: v1
+    labels:
+      version: v1
+`,
+			subset:       "v1",
+			expected:     true,
+			expectedMode: "ISTIO_MUTUAL",
+		},
+		"subset DISABLE": {
+			drYAML: `
+apiVersion: networking.istio.io/v1beta1
+kind: DestinationRule
+metadata:
+  name: reviews
+spec:
+  host: reviews
+  trafficPolicy:
+    tls:
+      mode: ISTIO_MUTUAL
+  subsets:
+  - name: v1
+    labels:
+      version: v1
+    trafficPolicy:
+      tls:
+        mode: DISABLE
+`,
+			subset:       "v1",
+			expected:     true,
+			expectedMode: "DISABLE",
+		},
+		"no tls": {
+			drYAML: `
+apiVersion: networking.istio.io/v1beta1
+kind: DestinationRule
+metadata:
+  name: reviews
+spec:
+  host: reviews
+  trafficPolicy:
+    loadBalancer:
+      simple: ROUND_ROBIN
+`,
+			expected:     false,
+			expectedMode: "",
+		},
+	}
+
+	for name, c := range cases {
+		var dr models.DestinationRule
+		assert.NoError(yaml.Unmarshal([]byte(c.drYAML), &dr), name)
+		assert.Equal(c.expected, dr.HasTLSSettings(c.subset), name)
+		assert.Equal(c.expectedMode, dr.TLSMode(c.subset), name)
+	}
+
+	// Testing nil case
+	var nilDR *models.DestinationRule
+	assert.False(nilDR.HasTLSSettings(""))
+	assert.Equal("", nilDR.TLSMode(""))
+}
+
+func TestDestinationRuleExportedBeyond(t *testing.T) {
+	assert := assert.New(t)
+
+	namespaces := []string{"bookinfo", "bookinfo2", "istio-system"}
+
+	cases := map[string]struct {
+		drExportTo  []string
+		svcExportTo []string
+		expected    []string
+	}{
+		"both exported everywhere": {
+			drExportTo:  nil,
+			svcExportTo: []string{"*"},
+			expected:    []string{},
+		},
+		"dr and service namespace local": {
+			drExportTo:  []string{"."},
+			svcExportTo: []string{"."},
+			expected:    []string{},
+		},
+		"dr everywhere and service namespace local": {
+			drExportTo:  []string{"*"},
+			svcExportTo: []string{"."},
+			expected:    []string{"bookinfo2", "istio-system"},
+		},
+		"dr to a namespace the service isn't exported to": {
+			drExportTo:  []string{".", "bookinfo2"},
+			svcExportTo: []string{".", "istio-system"},
+			expected:    []string{"bookinfo2"},
+		},
+		"dr narrower than service": {
+			drExportTo:  []string{"."},
+			svcExportTo: []string{"*"},
+			expected:    []string{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(tc.expected, models.ExportedBeyond(tc.drExportTo, "bookinfo", tc.svcExportTo, "bookinfo", namespaces))
+		})
+	}
+}
+
+func TestDestinationRuleConnectionPool(t *testing.T) {
+	assert := assert.New(t)
+
+	cases := map[string]struct {
+		drYAML         string
+		subset         string
+		expected       bool
+		expectedLimits models.ConnectionPoolLimits
+	}{
+		"tcp only": {
+			drYAML: `
+apiVersion: networking.istio.io/v1beta1
+kind: DestinationRule
+metadata:
+  name: reviews
+spec:
+  host: reviews
+  trafficPolicy:
+    connectionPool:
+      tcp:
+        maxConnections: 100
+`,
+			expected:       true,
+			expectedLimits: models.ConnectionPoolLimits{MaxConnections: 100},
+		},
+		"http only in subset": {
+			drYAML: `
+apiVersion: networking.istio.io/v1beta1
+kind: DestinationRule
+metadata:
+  name: reviews
+spec:
+  host: reviews
+  subsets:
+  - name: v1
+    labels:
+      version: v1
+    trafficPolicy:
+      connectionPool:
+        http:
+          http1MaxPendingRequests: 10
+          http2MaxRequests: 50
+`,
+			subset:         "v1",
+			expected:       true,
+			expectedLimits: models.ConnectionPoolLimits{HTTP1MaxPendingRequests: 10, HTTP2MaxRequests: 50},
+		},
+		"both with subset override": {
+			drYAML: `
+apiVersion: networking.istio.io/v1beta1
+kind: DestinationRule
+metadata:
+  name: reviews
+spec:
+  host: reviews
+  trafficPolicy:
+    connectionPool:
+      tcp:
+        maxConnections: 100
+  subsets:
+  - name: v1
+    labels:
+      version: v1
+    trafficPolicy:
+      connectionPool:
+        tcp:
+          maxConnections: 20
+        http:
+          http1MaxPendingRequests: 5
+`,
+			subset:         "v1",
+			expected:       true,
+			expectedLimits: models.ConnectionPoolLimits{MaxConnections: 20, HTTP1MaxPendingRequests: 5},
+		},
+		"none": {
+			drYAML: `
+apiVersion: networking.istio.io/v1beta1
+kind: DestinationRule
+metadata:
+  name: reviews
+spec:
+  host: reviews
+  trafficPolicy:
+    loadBalancer:
+      simple: ROUND_ROBIN
+`,
+			expected:       false,
+			expectedLimits: models.ConnectionPoolLimits{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var dr models.DestinationRule
+			assert.NoError(yaml.Unmarshal([]byte(tc.drYAML), &dr))
+
+			assert.Equal(tc.expected, dr.HasConnectionPool())
+			assert.Equal(tc.expectedLimits, dr.ConnectionPoolSettings(tc.subset))
+		})
+	}
+
+	// Testing nil case
+	var dr *models.DestinationRule
+	assert.False(dr.HasConnectionPool())
+	assert.Equal(models.ConnectionPoolLimits{}, dr.ConnectionPoolSettings(""))
+}
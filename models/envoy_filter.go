@@ -39,3 +39,8 @@ func (ef *EnvoyFilter) Parse(envoyFilter kubernetes.IstioObject) {
 	ef.Spec.WorkloadSelector = envoyFilter.GetSpec()["workloadSelector"]
 	ef.Spec.ConfigPatches = envoyFilter.GetSpec()["configPatches"]
 }
+
+// ContentHash returns a hash of the EnvoyFilter spec. Identical specs produce identical hashes.
+func (ef *EnvoyFilter) ContentHash() string {
+	return contentHash(ef.Spec)
+}
@@ -1,6 +1,9 @@
 package models
 
-import "github.com/kiali/kiali/kubernetes"
+import (
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/util/intutil"
+)
 
 type Gateways []Gateway
 type Gateway struct {
@@ -23,7 +26,124 @@ func (gw *Gateway) Parse(gateway kubernetes.IstioObject) {
 	gw.IstioBase.Parse(gateway)
 	gw.Spec.Servers = gateway.GetSpec()["servers"]
 	gw.Spec.Selector = make(map[string]string)
-	for k, v := range gateway.GetSpec()["selector"].(map[string]interface{}) {
-		gw.Spec.Selector[k] = v.(string)
+	// Proposed (dry-run) gateways may come without a valid selector
+	selector, _ := gateway.GetSpec()["selector"].(map[string]interface{})
+	for k, v := range selector {
+		if s, ok := v.(string); ok {
+			gw.Spec.Selector[k] = s
+		}
 	}
 }
+
+// ContentHash returns a hash of the Gateway spec. Identical specs produce identical hashes.
+func (gw *Gateway) ContentHash() string {
+	return contentHash(gw.Spec)
+}
+
+// GatewayServer is a typed view of a Gateway spec.servers entry
+type GatewayServer struct {
+	Port     int      `json:"port"`
+	Protocol string   `json:"protocol"`
+	Hosts    []string `json:"hosts"`
+	TLSMode  string   `json:"tlsMode,omitempty"`
+}
+
+// GatewayServerTLS is a typed view of the tls settings of a Gateway spec.servers entry
+type GatewayServerTLS struct {
+	Mode               string `json:"mode,omitempty"`
+	CredentialName     string `json:"credentialName,omitempty"`
+	ServerCertificate  string `json:"serverCertificate,omitempty"`
+	PrivateKey         string `json:"privateKey,omitempty"`
+	CaCertificates     string `json:"caCertificates,omitempty"`
+	MinProtocolVersion string `json:"minProtocolVersion,omitempty"`
+	MaxProtocolVersion string `json:"maxProtocolVersion,omitempty"`
+	HTTPSRedirect      bool   `json:"httpsRedirect,omitempty"`
+}
+
+// ParseGatewayServerTLS returns the tls settings of a Gateway server, or false when the server doesn't define them.
+func ParseGatewayServerTLS(server interface{}) (*GatewayServerTLS, bool) {
+	serverDef, ok := server.(map[string]interface{})
+	if !ok {
+		return nil, false
+	}
+	tlsDef, ok := serverDef["tls"].(map[string]interface{})
+	if !ok {
+		return nil, false
+	}
+
+	tls := &GatewayServerTLS{}
+	tls.Mode, _ = tlsDef["mode"].(string)
+	tls.CredentialName, _ = tlsDef["credentialName"].(string)
+	tls.ServerCertificate, _ = tlsDef["serverCertificate"].(string)
+	tls.PrivateKey, _ = tlsDef["privateKey"].(string)
+	tls.CaCertificates, _ = tlsDef["caCertificates"].(string)
+	tls.MinProtocolVersion, _ = tlsDef["minProtocolVersion"].(string)
+	tls.MaxProtocolVersion, _ = tlsDef["maxProtocolVersion"].(string)
+	tls.HTTPSRedirect, _ = tlsDef["httpsRedirect"].(bool)
+	return tls, true
+}
+
+// ServersTLS returns the tls settings of each server, in spec order. Servers without tls settings get nil.
+func (gw *Gateway) ServersTLS() []*GatewayServerTLS {
+	serversTLS := make([]*GatewayServerTLS, 0)
+	if gw == nil {
+		return serversTLS
+	}
+
+	if servers, ok := gw.Spec.Servers.([]interface{}); ok {
+		for _, server := range servers {
+			tls, _ := ParseGatewayServerTLS(server)
+			serversTLS = append(serversTLS, tls)
+		}
+	}
+	return serversTLS
+}
+
+// Servers returns the servers of the Gateway, in spec order.
+func (gw *Gateway) Servers() []GatewayServer {
+	gwServers := make([]GatewayServer, 0)
+	if gw == nil {
+		return gwServers
+	}
+
+	if servers, ok := gw.Spec.Servers.([]interface{}); ok {
+		for _, server := range servers {
+			serverDef, ok := server.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			gwServer := GatewayServer{Hosts: make([]string, 0)}
+			if port, ok := serverDef["port"].(map[string]interface{}); ok {
+				gwServer.Port, _ = intutil.Convert(port["number"])
+				gwServer.Protocol, _ = port["protocol"].(string)
+			}
+			if hosts, ok := serverDef["hosts"].([]interface{}); ok {
+				for _, host := range hosts {
+					if hostName, ok := host.(string); ok {
+						gwServer.Hosts = append(gwServer.Hosts, hostName)
+					}
+				}
+			}
+			if tls, found := ParseGatewayServerTLS(server); found {
+				gwServer.TLSMode = tls.Mode
+			}
+			gwServers = append(gwServers, gwServer)
+		}
+	}
+	return gwServers
+}
+
+// HasTLSServer determines if any server of the Gateway defines tls settings.
+func (gw *Gateway) HasTLSServer() bool {
+	for _, tls := range gw.ServersTLS() {
+		if tls != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// IsFileBased returns true when the certificates are mounted files instead of a credential
+func (tls *GatewayServerTLS) IsFileBased() bool {
+	return tls.CredentialName == "" && (tls.ServerCertificate != "" || tls.PrivateKey != "")
+}
@@ -0,0 +1,130 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/yaml"
+
+	"github.com/kiali/kiali/models"
+)
+
+func TestGatewayServersTLS(t *testing.T) {
+	assert := assert.New(t)
+
+	gwYAML := []byte(`
+apiVersion: networking.istio.io/v1beta1
+kind: Gateway
+metadata:
+  name: bookinfo-gateway
+spec:
+  selector:
+    istio: ingressgateway
+  servers:
+  - port:
+      number: 80
+      name: http
+      protocol: HTTP
+    hosts:
+    - bookinfo.example.com
+  - port:
+      number: 443
+      name: https
+      protocol: HTTPS
+    hosts:
+    - bookinfo.example.com
+    tls:
+      mode: SIMPLE
+      credentialName: bookinfo-credential
+      minProtocolVersion: TLSV1_2
+  - port:
+      number: 8443
+      name: https-mutual
+      protocol: HTTPS
+    hosts:
+    - partners.example.com
+    tls:
+      mode: MUTUAL
+      serverCertificate: /etc/certs/servercert.pem
+      privateKey: /etc/certs/privatekey.pem
+      caCertificates: /etc/certs/caroot.pem
+      maxProtocolVersion: TLSV1_3
+`)
+
+	var gw models.Gateway
+	assert.NoError(yaml.Unmarshal(gwYAML, &gw))
+
+	serversTLS := gw.ServersTLS()
+	assert.Len(serversTLS, 3)
+
+	assert.Nil(serversTLS[0])
+
+	assert.Equal(&models.GatewayServerTLS{
+		Mode:               "SIMPLE",
+		CredentialName:     "bookinfo-credential",
+		MinProtocolVersion: "TLSV1_2",
+	}, serversTLS[1])
+	assert.False(serversTLS[1].IsFileBased())
+
+	assert.Equal(&models.GatewayServerTLS{
+		Mode:               "MUTUAL",
+		ServerCertificate:  "/etc/certs/servercert.pem",
+		PrivateKey:         "/etc/certs/privatekey.pem",
+		CaCertificates:     "/etc/certs/caroot.pem",
+		MaxProtocolVersion: "TLSV1_3",
+	}, serversTLS[2])
+	assert.True(serversTLS[2].IsFileBased())
+
+	// Testing nil case
+	var nilGW *models.Gateway
+	assert.Empty(nilGW.ServersTLS())
+}
+
+func TestGatewayServers(t *testing.T) {
+	assert := assert.New(t)
+
+	gwYAML := []byte(`
+apiVersion: networking.istio.io/v1beta1
+kind: Gateway
+metadata:
+  name: bookinfo-gateway
+spec:
+  selector:
+    istio: ingressgateway
+  servers:
+  - port:
+      number: 80
+      name: http
+      protocol: HTTP
+    hosts:
+    - bookinfo.example.com
+  - port:
+      number: 443
+      name: https
+      protocol: HTTPS
+    hosts:
+    - bookinfo.example.com
+    - "*.bookinfo.example.com"
+    tls:
+      mode: SIMPLE
+      credentialName: bookinfo-credential
+`)
+
+	var gw models.Gateway
+	assert.NoError(yaml.Unmarshal(gwYAML, &gw))
+
+	assert.Equal([]models.GatewayServer{
+		{Port: 80, Protocol: "HTTP", Hosts: []string{"bookinfo.example.com"}},
+		{Port: 443, Protocol: "HTTPS", Hosts: []string{"bookinfo.example.com", "*.bookinfo.example.com"}, TLSMode: "SIMPLE"},
+	}, gw.Servers())
+	assert.True(gw.HasTLSServer())
+
+	gw.Spec.Servers = gw.Spec.Servers.([]interface{})[:1]
+	assert.Len(gw.Servers(), 1)
+	assert.False(gw.HasTLSServer())
+
+	// Testing nil case
+	var nilGW *models.Gateway
+	assert.Empty(nilGW.Servers())
+	assert.False(nilGW.HasTLSServer())
+}
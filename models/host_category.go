@@ -0,0 +1,59 @@
+package models
+
+import (
+	"strings"
+
+	core_v1 "k8s.io/api/core/v1"
+
+	"github.com/kiali/kiali/kubernetes"
+)
+
+// HostCategory is the kind of registry entry a host resolves to
+type HostCategory string
+
+const (
+	HostKubeService          HostCategory = "KubeService"
+	HostServiceEntryInternal HostCategory = "ServiceEntryInternal"
+	HostServiceEntryExternal HostCategory = "ServiceEntryExternal"
+	HostWildcard             HostCategory = "Wildcard"
+	HostRegistry             HostCategory = "Registry"
+	HostUnknown              HostCategory = "Unknown"
+)
+
+// ClassifyHost returns the category of the host referenced from an object of itemNamespace.
+// Categories are tested in order: wildcard hosts, local Kubernetes services (or workloads labeled
+// with the service app), ServiceEntries and finally the Istio registry, for hosts that may not
+// be covered otherwise, i.e. multi-cluster or federation.
+func ClassifyHost(host kubernetes.Host, itemNamespace string, workloads WorkloadList, services []core_v1.Service, serviceEntries []kubernetes.IstioObject, registryStatus []*kubernetes.RegistryStatus) HostCategory {
+	// Check wildcard hosts - needs to match "*" and "*.suffix" also..
+	if strings.HasPrefix(host.Service, "*") {
+		return HostWildcard
+	}
+
+	// Covering 'servicename.namespace' host format scenario
+	localSvc, localNs := kubernetes.ParseTwoPartHost(host)
+
+	if localNs == itemNamespace {
+		if kubernetes.HasMatchingWorkloads(localSvc, workloads.GetLabels()) || kubernetes.HasMatchingServices(localSvc, services) {
+			return HostKubeService
+		}
+	}
+
+	for _, se := range serviceEntries {
+		seHosts := kubernetes.ServiceEntryHostnames([]kubernetes.IstioObject{se})
+		// FQDN hosts are parsed into their service part, but ServiceEntry wildcards may span the whole hostname
+		if kubernetes.HasMatchingServiceEntries(host.Service, seHosts) || kubernetes.HasMatchingServiceEntries(host.String(), seHosts) {
+			// Istio defaults to MESH_EXTERNAL when the location is not set
+			if location, ok := se.GetSpec()["location"].(string); ok && location == "MESH_INTERNAL" {
+				return HostServiceEntryInternal
+			}
+			return HostServiceEntryExternal
+		}
+	}
+
+	if kubernetes.MatchRegistryHost(host.String(), itemNamespace, registryStatus) {
+		return HostRegistry
+	}
+
+	return HostUnknown
+}
@@ -0,0 +1,49 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	core_v1 "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+)
+
+func TestClassifyHost(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	namespaces := []string{"test", "outside-ns"}
+	services := []core_v1.Service{
+		{
+			ObjectMeta: meta_v1.ObjectMeta{Name: "reviews", Namespace: "test"},
+			Spec:       core_v1.ServiceSpec{Selector: map[string]string{"app": "reviews"}},
+		},
+	}
+
+	externalSE := data.CreateEmptyMeshExternalServiceEntry("sni-proxy", "test", []string{"sni-proxy.local"})
+	internalSE := data.CreateEmptyMeshExternalServiceEntry("mongo", "test", []string{"mymongodb.somedomain"})
+	internalSE.GetSpec()["location"] = "MESH_INTERNAL"
+	serviceEntries := []kubernetes.IstioObject{externalSE, internalSE}
+
+	registryService := kubernetes.RegistryStatus{}
+	registryService.Hostname = "reviews.outside-ns.svc.cluster.local"
+	registryStatus := []*kubernetes.RegistryStatus{&registryService}
+
+	classify := func(hostName string) models.HostCategory {
+		host := kubernetes.GetHost(hostName, "test", "svc.cluster.local", namespaces)
+		return models.ClassifyHost(host, "test", models.WorkloadList{}, services, serviceEntries, registryStatus)
+	}
+
+	assert.Equal(models.HostKubeService, classify("reviews"))
+	assert.Equal(models.HostKubeService, classify("reviews.test.svc.cluster.local"))
+	assert.Equal(models.HostServiceEntryExternal, classify("sni-proxy.local"))
+	assert.Equal(models.HostServiceEntryInternal, classify("mymongodb.somedomain"))
+	assert.Equal(models.HostWildcard, classify("*.local"))
+	assert.Equal(models.HostRegistry, classify("reviews.outside-ns.svc.cluster.local"))
+	assert.Equal(models.HostUnknown, classify("ratings"))
+}
@@ -1,6 +1,10 @@
 package models
 
 import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/kiali/kiali/kubernetes"
@@ -17,3 +21,14 @@ func (ib *IstioBase) Parse(io kubernetes.IstioObject) {
 	ib.Metadata = io.GetObjectMeta()
 	ib.Status = io.GetStatus()
 }
+
+// contentHash returns a sha256 hex digest of the JSON representation of spec.
+// JSON marshalling sorts map keys, so the spec is normalized before hashing.
+// It can be used as a cache key when no resourceVersion is available.
+func contentHash(spec interface{}) string {
+	bytes, err := json.Marshal(spec)
+	if err != nil {
+		return ""
+	}
+	return fmt.Sprintf("%x", sha256.Sum256(bytes))
+}
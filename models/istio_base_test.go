@@ -0,0 +1,42 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+)
+
+func TestContentHashIdenticalSpecs(t *testing.T) {
+	assert := assert.New(t)
+
+	vs1, vs2 := models.VirtualService{}, models.VirtualService{}
+	vs1.Parse(data.CreateVirtualService())
+	vs2.Parse(data.CreateVirtualService())
+	// Metadata is not part of the hash
+	vs2.Metadata.Name = "reviews-copy"
+
+	assert.NotEmpty(vs1.ContentHash())
+	assert.Equal(vs1.ContentHash(), vs2.ContentHash())
+}
+
+func TestContentHashChangedSpec(t *testing.T) {
+	assert := assert.New(t)
+
+	dr1, dr2 := models.DestinationRule{}, models.DestinationRule{}
+	dr1.Parse(data.CreateTestDestinationRule("bookinfo", "reviews", "reviews"))
+	dr2.Parse(data.AddTrafficPolicyToDestinationRule(data.CreateMTLSTrafficPolicyForDestinationRules(),
+		data.CreateTestDestinationRule("bookinfo", "reviews", "reviews")))
+
+	assert.NotEqual(dr1.ContentHash(), dr2.ContentHash())
+
+	se1, se2 := models.ServiceEntry{}, models.ServiceEntry{}
+	se1.Parse(data.CreateExternalServiceEntry())
+	se2.Parse(data.CreateExternalServiceEntry())
+	assert.Equal(se1.ContentHash(), se2.ContentHash())
+
+	se2.Spec.Resolution = "STATIC"
+	assert.NotEqual(se1.ContentHash(), se2.ContentHash())
+}
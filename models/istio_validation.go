@@ -82,13 +82,58 @@ type IstioCheck struct {
 	// String that describes where in the yaml file is the check located
 	// example: spec/http[0]/route
 	Path string `json:"path"`
+
+	// Suggested fix, only set when a deterministic one exists
+	// example: Deploy a workload of the host service labeled version=v2, or fix the subset labels
+	Remediation string `json:"remediation,omitempty"`
 }
 
+// ValidationRule describes one of the checks performed by Kiali validations
+// swagger:model
+type ValidationRule struct {
+	// Message key of the check
+	// required: true
+	// example: destinationrules.nodest.subsetlabels
+	Key string `json:"key"`
+
+	// The check code used to identify a check
+	// required: true
+	// example: KIA0203
+	Code string `json:"code"`
+
+	// Description of the check
+	// required: true
+	// example: This subset's labels are not found in any matching host
+	Message string `json:"message"`
+
+	// Default severity of the check
+	// required: true
+	// example: error
+	Severity SeverityLevel `json:"severity"`
+
+	// Types of the objects that can report the check
+	// example: ["destinationrule"]
+	ObjectTypes []string `json:"objectTypes"`
+
+	// Category of the check
+	// example: security
+	Category ValidationCategory `json:"category"`
+}
+
+// ValidationCategory groups the checks by the concern they validate
+type ValidationCategory string
+
+const (
+	SecurityValidationCategory ValidationCategory = "security"
+	TrafficValidationCategory  ValidationCategory = "traffic"
+)
+
 type SeverityLevel string
 
 const (
 	ErrorSeverity   SeverityLevel = "error"
 	WarningSeverity SeverityLevel = "warning"
+	InfoSeverity    SeverityLevel = "info"
 	Unknown         SeverityLevel = "unknown"
 )
 
@@ -108,215 +153,677 @@ var ObjectTypeSingular = map[string]string{
 	"sidecars":               "sidecar",
 	"peerauthentications":    "peerauthentication",
 	"requestauthentications": "requestauthentication",
+	"envoyfilters":           "envoyfilter",
+	"workloadentries":        "workloadentry",
+	"telemetries":            "telemetry",
+}
+
+// checkDescriptor describes a check that validations can report, along with the object types whose checkers report it
+type checkDescriptor struct {
+	Code        string
+	Message     string
+	Severity    SeverityLevel
+	ObjectTypes []string
 }
 
-var checkDescriptors = map[string]IstioCheck{
+var checkDescriptors = map[string]checkDescriptor{
 	"authorizationpolicy.source.namespacenotfound": {
-		Code:     "KIA0101",
-		Message:  "Namespace not found for this rule",
-		Severity: WarningSeverity,
+		Code:        "KIA0101",
+		Message:     "Namespace not found for this rule",
+		Severity:    WarningSeverity,
+		ObjectTypes: []string{"authorizationpolicy"},
 	},
 	"authorizationpolicy.to.wrongmethod": {
-		Code:     "KIA0102",
-		Message:  "Only HTTP methods and fully-qualified gRPC names are allowed",
-		Severity: WarningSeverity,
+		Code:        "KIA0102",
+		Message:     "Only HTTP methods and fully-qualified gRPC names are allowed",
+		Severity:    WarningSeverity,
+		ObjectTypes: []string{"authorizationpolicy"},
 	},
 	"authorizationpolicy.nodest.matchingregistry": {
-		Code:     "KIA0104",
-		Message:  "This host has no matching entry in the service registry",
-		Severity: ErrorSeverity,
+		Code:        "KIA0104",
+		Message:     "This host has no matching entry in the service registry",
+		Severity:    ErrorSeverity,
+		ObjectTypes: []string{"authorizationpolicy"},
 	},
 	"authorizationpolicy.mtls.needstobeenabled": {
-		Code:     "KIA0105",
-		Message:  "This field requires mTLS to be enabled",
-		Severity: ErrorSeverity,
+		Code:        "KIA0105",
+		Message:     "This field requires mTLS to be enabled",
+		Severity:    ErrorSeverity,
+		ObjectTypes: []string{"authorizationpolicy"},
+	},
+	"authorizationpolicy.ingressgateway.allowall": {
+		Code:        "KIA0106",
+		Message:     "This rule allows any request to reach the ingress gateway",
+		Severity:    InfoSeverity,
+		ObjectTypes: []string{"authorizationpolicy"},
+	},
+	"authorizationpolicy.custom.providernotfound": {
+		Code:        "KIA0107",
+		Message:     "Extension provider not found in the mesh config",
+		Severity:    ErrorSeverity,
+		ObjectTypes: []string{"authorizationpolicy"},
+	},
+	"authorizationpolicy.when.invalidsourceip": {
+		Code:        "KIA0108",
+		Message:     "source.ip values must be IPs or CIDRs, otherwise they never match",
+		Severity:    ErrorSeverity,
+		ObjectTypes: []string{"authorizationpolicy"},
+	},
+	"authorizationpolicy.source.principalnotfound": {
+		Code:        "KIA0109",
+		Message:     "Namespace or service account not found for this principal",
+		Severity:    WarningSeverity,
+		ObjectTypes: []string{"authorizationpolicy"},
+	},
+	"authorizationpolicy.allow.norules": {
+		Code:        "KIA0110",
+		Message:     "ALLOW policy without rules matches no request: it denies all the traffic",
+		Severity:    WarningSeverity,
+		ObjectTypes: []string{"authorizationpolicy"},
+	},
+	"authorizationpolicy.selector.nomatch": {
+		Code:        "KIA0111",
+		Message:     "Selector matches no workload in this namespace: the policy applies to nothing",
+		Severity:    WarningSeverity,
+		ObjectTypes: []string{"authorizationpolicy"},
 	},
 	"destinationrules.multimatch": {
-		Code:     "KIA0201",
-		Message:  "More than one DestinationRules for the same host subset combination",
-		Severity: WarningSeverity,
+		Code:        "KIA0201",
+		Message:     "More than one DestinationRules for the same host subset combination",
+		Severity:    WarningSeverity,
+		ObjectTypes: []string{"destinationrule"},
 	},
 	"destinationrules.nodest.matchingregistry": {
-		Code:     "KIA0202",
-		Message:  "This host has no matching entry in the service registry (service, workload or service entries)",
-		Severity: ErrorSeverity,
+		Code:        "KIA0202",
+		Message:     "This host has no matching entry in the service registry (service, workload or service entries)",
+		Severity:    ErrorSeverity,
+		ObjectTypes: []string{"destinationrule"},
+	},
+	"destinationrules.nodest.servicemissinginns": {
+		Code:        "KIA0220",
+		Message:     "The host namespace exists but has no service or registry entry for this host",
+		Severity:    ErrorSeverity,
+		ObjectTypes: []string{"destinationrule"},
 	},
 	"destinationrules.nodest.subsetlabels": {
-		Code:     "KIA0203",
-		Message:  "This subset's labels are not found in any matching host",
-		Severity: ErrorSeverity,
+		Code:        "KIA0203",
+		Message:     "This subset's labels are not found in any matching host",
+		Severity:    ErrorSeverity,
+		ObjectTypes: []string{"destinationrule"},
 	},
 	"destinationrules.trafficpolicy.notlssettings": {
-		Code:     "KIA0204",
-		Message:  "mTLS settings of a non-local Destination Rule are overridden",
-		Severity: WarningSeverity,
+		Code:        "KIA0204",
+		Message:     "mTLS settings of a non-local Destination Rule are overridden",
+		Severity:    WarningSeverity,
+		ObjectTypes: []string{"destinationrule"},
 	},
 	"destinationrules.mtls.meshpolicymissing": {
-		Code:     "KIA0205",
-		Message:  "PeerAuthentication enabling mTLS at mesh level is missing",
-		Severity: ErrorSeverity,
+		Code:        "KIA0205",
+		Message:     "PeerAuthentication enabling mTLS at mesh level is missing",
+		Severity:    ErrorSeverity,
+		ObjectTypes: []string{"destinationrule"},
 	},
 	"destinationrules.mtls.nspolicymissing": {
-		Code:     "KIA0206",
-		Message:  "PeerAuthentication enabling namespace-wide mTLS is missing",
-		Severity: ErrorSeverity,
+		Code:        "KIA0206",
+		Message:     "PeerAuthentication enabling namespace-wide mTLS is missing",
+		Severity:    ErrorSeverity,
+		ObjectTypes: []string{"destinationrule"},
 	},
 	"destinationrules.mtls.policymtlsenabled": {
-		Code:     "KIA0207",
-		Message:  "PeerAuthentication with TLS strict mode found, it should be permissive",
-		Severity: ErrorSeverity,
+		Code:        "KIA0207",
+		Message:     "PeerAuthentication with TLS strict mode found, it should be permissive",
+		Severity:    ErrorSeverity,
+		ObjectTypes: []string{"destinationrule"},
 	},
 	"destinationrules.mtls.meshpolicymtlsenabled": {
-		Code:     "KIA0208",
-		Message:  "PeerAuthentication enabling mTLS found, permissive mode needed",
-		Severity: ErrorSeverity,
+		Code:        "KIA0208",
+		Message:     "PeerAuthentication enabling mTLS found, permissive mode needed",
+		Severity:    ErrorSeverity,
+		ObjectTypes: []string{"destinationrule"},
 	},
 	"destinationrules.nodest.subsetnolabels": {
-		Code:     "KIA0209",
-		Message:  "This subset has not labels",
-		Severity: WarningSeverity,
+		Code:        "KIA0209",
+		Message:     "This subset has not labels",
+		Severity:    WarningSeverity,
+		ObjectTypes: []string{"destinationrule"},
+	},
+	"destinationrules.outlier.ejectpercentoutofrange": {
+		Code:        "KIA0218",
+		Message:     "maxEjectionPercent must be between 0 and 100",
+		Severity:    WarningSeverity,
+		ObjectTypes: []string{"destinationrule"},
+	},
+	"destinationrules.outlier.noejectiontrigger": {
+		Code:        "KIA0219",
+		Message:     "baseEjectionTime is set but consecutive5xxErrors of 0 leaves no errors to eject hosts on",
+		Severity:    WarningSeverity,
+		ObjectTypes: []string{"destinationrule"},
+	},
+	"destinationrules.trafficpolicy.outlierdetectionmismatch": {
+		Code:        "KIA0210",
+		Message:     "Subset outlier detection differs from the host-level outlier detection",
+		Severity:    InfoSeverity,
+		ObjectTypes: []string{"destinationrule"},
+	},
+	"destinationrules.serviceentries.resolutionconflict": {
+		Code:        "KIA0211",
+		Message:     "Host is covered by multiple ServiceEntries with different resolutions",
+		Severity:    WarningSeverity,
+		ObjectTypes: []string{"destinationrule"},
+	},
+	"destinationrules.multimatch.subsets": {
+		Code:        "KIA0212",
+		Message:     "This subset's labels match the same workloads as another subset",
+		Severity:    WarningSeverity,
+		ObjectTypes: []string{"destinationrule"},
+	},
+	"destinationrules.externalname.serviceentrymissing": {
+		Code:        "KIA0213",
+		Message:     "Host is an ExternalName service without a ServiceEntry: traffic policy might not be applied",
+		Severity:    InfoSeverity,
+		ObjectTypes: []string{"destinationrule"},
+	},
+	"destinationrules.trafficpolicy.warmupsingleendpoint": {
+		Code:        "KIA0214",
+		Message:     "Warmup duration has no effect: the service has a single endpoint",
+		Severity:    InfoSeverity,
+		ObjectTypes: []string{"destinationrule"},
+	},
+	"destinationrules.exportto.broaderthanservice": {
+		Code:        "KIA0215",
+		Message:     "DestinationRule is exported to namespaces where its host service is not visible",
+		Severity:    WarningSeverity,
+		ObjectTypes: []string{"destinationrule"},
+	},
+	"destinationrules.exportto.subsetnotvisible": {
+		Code:        "KIA0216",
+		Message:     "DestinationRule defines a subset routed from a namespace it is not exported to",
+		Severity:    ErrorSeverity,
+		ObjectTypes: []string{"destinationrule"},
+	},
+	"destinationrules.subset.inconsistentversionlabel": {
+		Code:        "KIA0217",
+		Message:     "Some workloads of the host lack the version label this subset relies on",
+		Severity:    WarningSeverity,
+		ObjectTypes: []string{"destinationrule"},
+	},
+	"destinationrules.consistenthash.nokey": {
+		Code:        "KIA0221",
+		Message:     "consistentHash sets no hash key: requests won't stick to any endpoint",
+		Severity:    WarningSeverity,
+		ObjectTypes: []string{"destinationrule"},
+	},
+	"destinationrules.trafficpolicy.tlsmodeistiomutual": {
+		Code:        "KIA0222",
+		Message:     "ServiceEntry declares TLS ports for this host: ISTIO_MUTUAL breaks the traffic to the external TLS endpoint",
+		Severity:    WarningSeverity,
+		ObjectTypes: []string{"destinationrule"},
+	},
+	"destinationrules.subset.labelnotfound": {
+		Code:        "KIA0223",
+		Message:     "Subset uses labels not found on any workload of the host service",
+		Severity:    WarningSeverity,
+		ObjectTypes: []string{"destinationrule"},
+	},
+	"envoyfilters.applyto.mismatchedmatch": {
+		Code:        "KIA1501",
+		Message:     "Match type doesn't fit the applyTo objects: the patch never applies",
+		Severity:    WarningSeverity,
+		ObjectTypes: []string{"envoyfilter"},
+	},
+	"envoyfilters.applyto.missingmatch": {
+		Code:        "KIA1502",
+		Message:     "Patch operation needs a match naming the filter it operates on",
+		Severity:    WarningSeverity,
+		ObjectTypes: []string{"envoyfilter"},
 	},
 	"gateways.multimatch": {
-		Code:     "KIA0301",
-		Message:  "More than one Gateway for the same host port combination",
-		Severity: WarningSeverity,
+		Code:        "KIA0301",
+		Message:     "More than one Gateway for the same host port combination",
+		Severity:    WarningSeverity,
+		ObjectTypes: []string{"gateway"},
 	},
 	"gateways.selector": {
-		Code:     "KIA0302",
-		Message:  "No matching workload found for gateway selector in this namespace",
-		Severity: WarningSeverity,
+		Code:        "KIA0302",
+		Message:     "No matching workload found for gateway selector in this namespace",
+		Severity:    WarningSeverity,
+		ObjectTypes: []string{"gateway"},
+	},
+	"gateways.hosts.namespacenotfound": {
+		Code:        "KIA0303",
+		Message:     "Namespace not found or not accessible for this host",
+		Severity:    WarningSeverity,
+		ObjectTypes: []string{"gateway"},
+	},
+	"gateways.port.duplicatename": {
+		Code:        "KIA0304",
+		Message:     "Port name must be unique among the Gateway servers",
+		Severity:    ErrorSeverity,
+		ObjectTypes: []string{"gateway"},
+	},
+	"gateways.tls.certificatemissing": {
+		Code:        "KIA0305",
+		Message:     "TLS mode requires a credentialName or both serverCertificate and privateKey",
+		Severity:    ErrorSeverity,
+		ObjectTypes: []string{"gateway"},
+	},
+	"gateways.hostnotcovered": {
+		Code:        "KIA0306",
+		Message:     "No VirtualService bound to this gateway defines a matching host",
+		Severity:    WarningSeverity,
+		ObjectTypes: []string{"gateway"},
+	},
+	"gateways.tls.credentialnotfound": {
+		Code:        "KIA0307",
+		Message:     "Secret referenced by credentialName not found: the listener won't come up",
+		Severity:    ErrorSeverity,
+		ObjectTypes: []string{"gateway"},
 	},
 	"generic.exportto.namespacenotfound": {
-		Code:     "KIA0005",
-		Message:  "No matching namespace found or namespace is not accessible",
-		Severity: ErrorSeverity,
+		Code:        "KIA0005",
+		Message:     "No matching namespace found or namespace is not accessible",
+		Severity:    ErrorSeverity,
+		ObjectTypes: []string{"destinationrule", "serviceentry", "virtualservice"},
+	},
+	"generic.naming.convention": {
+		Code:        "KIA0006",
+		Message:     "Name doesn't match the naming convention configured for this object type",
+		Severity:    WarningSeverity,
+		ObjectTypes: []string{"authorizationpolicy", "destinationrule", "gateway", "serviceentry", "sidecar", "virtualservice"},
 	},
 	"generic.multimatch.selectorless": {
-		Code:     "KIA0002",
-		Message:  "More than one selector-less object in the same namespace",
-		Severity: ErrorSeverity,
+		Code:        "KIA0002",
+		Message:     "More than one selector-less object in the same namespace",
+		Severity:    ErrorSeverity,
+		ObjectTypes: []string{"peerauthentication", "requestauthentication", "sidecar"},
 	},
 	"generic.multimatch.selector": {
-		Code:     "KIA0003",
-		Message:  "More than one object applied to the same workload",
-		Severity: ErrorSeverity,
+		Code:        "KIA0003",
+		Message:     "More than one object applied to the same workload",
+		Severity:    ErrorSeverity,
+		ObjectTypes: []string{"peerauthentication", "requestauthentication", "sidecar"},
 	},
 	"generic.selector.workloadnotfound": {
-		Code:     "KIA0004",
-		Message:  "No matching workload found for the selector in this namespace",
-		Severity: WarningSeverity,
+		Code:        "KIA0004",
+		Message:     "No matching workload found for the selector in this namespace",
+		Severity:    WarningSeverity,
+		ObjectTypes: []string{"peerauthentication", "requestauthentication", "sidecar"},
+	},
+	"generic.workloads.noneready": {
+		Code:        "KIA0007",
+		Message:     "None of the workloads of the target service are ready",
+		Severity:    InfoSeverity,
+		ObjectTypes: []string{"destinationrule", "virtualservice"},
 	},
 	"peerauthentication.mtls.destinationrulemissing": {
-		Code:     "KIA0401",
-		Message:  "Mesh-wide Destination Rule enabling mTLS is missing",
-		Severity: ErrorSeverity,
+		Code:        "KIA0401",
+		Message:     "Mesh-wide Destination Rule enabling mTLS is missing",
+		Severity:    ErrorSeverity,
+		ObjectTypes: []string{"peerauthentication"},
+	},
+	"peerauthentications.meshdefault.notrootnamespace": {
+		Code:        "KIA0507",
+		Message:     "PeerAuthentication without selector is only mesh-wide in the Istio root namespace",
+		Severity:    WarningSeverity,
+		ObjectTypes: []string{"peerauthentication"},
 	},
 	"peerauthentications.mtls.destinationrulemissing": {
-		Code:     "KIA0501",
-		Message:  "Destination Rule enabling namespace-wide mTLS is missing",
-		Severity: ErrorSeverity,
+		Code:        "KIA0501",
+		Message:     "Destination Rule enabling namespace-wide mTLS is missing",
+		Severity:    ErrorSeverity,
+		ObjectTypes: []string{"peerauthentication"},
 	},
 	"peerauthentications.mtls.disabledestinationrulemissing": {
-		Code:     "KIA0505",
-		Message:  "Destination Rule disabling namespace-wide mTLS is missing",
-		Severity: ErrorSeverity,
+		Code:        "KIA0505",
+		Message:     "Destination Rule disabling namespace-wide mTLS is missing",
+		Severity:    ErrorSeverity,
+		ObjectTypes: []string{"peerauthentication"},
 	},
 	"peerauthentications.mtls.disablemeshdestinationrulemissing": {
-		Code:     "KIA0506",
-		Message:  "Destination Rule disabling mesh-wide mTLS is missing",
-		Severity: ErrorSeverity,
+		Code:        "KIA0506",
+		Message:     "Destination Rule disabling mesh-wide mTLS is missing",
+		Severity:    ErrorSeverity,
+		ObjectTypes: []string{"peerauthentication"},
+	},
+	"peerauthentications.mtls.portnotfound": {
+		Code:        "KIA0508",
+		Message:     "Port not found in the services of the selected workloads",
+		Severity:    WarningSeverity,
+		ObjectTypes: []string{"peerauthentication"},
 	},
 	"port.name.mismatch": {
-		Code:     "KIA0601",
-		Message:  "Port name must follow <protocol>[-suffix] form",
-		Severity: ErrorSeverity,
+		Code:        "KIA0601",
+		Message:     "Port name must follow <protocol>[-suffix] form",
+		Severity:    ErrorSeverity,
+		ObjectTypes: []string{"gateway", "service", "serviceentry"},
+	},
+	"port.number.outofrange": {
+		Code:        "KIA0602",
+		Message:     "Port number must be between 1 and 65535",
+		Severity:    ErrorSeverity,
+		ObjectTypes: []string{"gateway", "serviceentry"},
+	},
+	"port.name.duplicate": {
+		Code:        "KIA0603",
+		Message:     "Port name must be unique",
+		Severity:    ErrorSeverity,
+		ObjectTypes: []string{"serviceentry"},
+	},
+	"requestauthentications.jwt.invalidjwks": {
+		Code:        "KIA1302",
+		Message:     "Inline jwks is not valid JSON",
+		Severity:    ErrorSeverity,
+		ObjectTypes: []string{"requestauthentication"},
+	},
+	"requestauthentications.jwt.nojwksnouri": {
+		Code:        "KIA1301",
+		Message:     "JWT rule has neither jwks, jwksUri nor issuer",
+		Severity:    ErrorSeverity,
+		ObjectTypes: []string{"requestauthentication"},
+	},
+	"serviceentries.dnsnoendpoints": {
+		Code:        "KIA1202",
+		Message:     "DNS resolution needs hostname endpoints, these endpoints are IP addresses only",
+		Severity:    WarningSeverity,
+		ObjectTypes: []string{"serviceentry"},
+	},
+	"serviceentries.host.alreadydefined": {
+		Code:        "KIA1204",
+		Message:     "Host is already defined by an in-mesh service, which this ServiceEntry shadows",
+		Severity:    WarningSeverity,
+		ObjectTypes: []string{"serviceentry"},
+	},
+	"serviceentries.hosts.ipaddress": {
+		Code:        "KIA1203",
+		Message:     "Host is an IP address, IPs should be listed in addresses",
+		Severity:    WarningSeverity,
+		ObjectTypes: []string{"serviceentry"},
+	},
+	"serviceentries.resolution.nonebalanced": {
+		Code:        "KIA1205",
+		Message:     "NONE resolution passes traffic through, the DestinationRule load balancing and outlier detection have no endpoints to apply to",
+		Severity:    WarningSeverity,
+		ObjectTypes: []string{"serviceentry"},
+	},
+	"serviceentries.staticnoaddress": {
+		Code:        "KIA1201",
+		Message:     "STATIC resolution needs endpoints with addresses",
+		Severity:    WarningSeverity,
+		ObjectTypes: []string{"serviceentry"},
 	},
 	"service.deployment.port.mismatch": {
-		Code:     "KIA0701",
-		Message:  "Deployment exposing same port as Service not found",
-		Severity: WarningSeverity,
+		Code:        "KIA0701",
+		Message:     "Deployment exposing same port as Service not found",
+		Severity:    WarningSeverity,
+		ObjectTypes: []string{"service"},
+	},
+	"service.destinationrule.subsets.missing": {
+		Code:        "KIA0702",
+		Message:     "Service has workloads with multiple versions but no DestinationRule defines subsets",
+		Severity:    InfoSeverity,
+		ObjectTypes: []string{"service"},
 	},
 	"servicerole.invalid.services": {
-		Code:     "KIA0901",
-		Message:  "Unable to find all the defined services",
-		Severity: ErrorSeverity,
+		Code:        "KIA0901",
+		Message:     "Unable to find all the defined services",
+		Severity:    ErrorSeverity,
+		ObjectTypes: []string{"servicerole"},
 	},
 	"servicerole.invalid.namespace": {
-		Code:     "KIA0902",
-		Message:  "ServiceRole can only point to current namespace",
-		Severity: ErrorSeverity,
+		Code:        "KIA0902",
+		Message:     "ServiceRole can only point to current namespace",
+		Severity:    ErrorSeverity,
+		ObjectTypes: []string{"servicerole"},
 	},
 	"servicerolebinding.invalid.role": {
-		Code:     "KIA0903",
-		Message:  "ServiceRole does not exists in this namespace",
-		Severity: ErrorSeverity,
+		Code:        "KIA0903",
+		Message:     "ServiceRole does not exists in this namespace",
+		Severity:    ErrorSeverity,
+		ObjectTypes: []string{"servicerolebinding"},
 	},
 	"sidecar.egress.invalidhostformat": {
-		Code:     "KIA1003",
-		Message:  "Invalid host format. 'namespace/dnsName' format expected",
-		Severity: ErrorSeverity,
+		Code:        "KIA1003",
+		Message:     "Invalid host format. 'namespace/dnsName' format expected",
+		Severity:    ErrorSeverity,
+		ObjectTypes: []string{"sidecar"},
 	},
 	"sidecar.egress.servicenotfound": {
-		Code:     "KIA1004",
-		Message:  "This host has no matching entry in the service registry",
-		Severity: WarningSeverity,
+		Code:        "KIA1004",
+		Message:     "This host has no matching entry in the service registry",
+		Severity:    WarningSeverity,
+		ObjectTypes: []string{"sidecar"},
 	},
 	"sidecar.global.selector": {
-		Code:     "KIA1006",
-		Message:  "Global default sidecar should not have workloadSelector",
-		Severity: WarningSeverity,
+		Code:        "KIA1006",
+		Message:     "Global default sidecar should not have workloadSelector",
+		Severity:    WarningSeverity,
+		ObjectTypes: []string{"sidecar"},
+	},
+	"sidecars.egresshost.namespacenotfound": {
+		Code:        "KIA1007",
+		Message:     "Namespace not found or not accessible for this egress host",
+		Severity:    WarningSeverity,
+		ObjectTypes: []string{"sidecar"},
+	},
+	"telemetries.provider.notfound": {
+		Code:        "KIA1401",
+		Message:     "Provider not found in the mesh config extensionProviders",
+		Severity:    WarningSeverity,
+		ObjectTypes: []string{"telemetry"},
 	},
 	"virtualservices.gateway.oldnomenclature": {
-		Code:     "KIA1108",
-		Message:  "Preferred nomenclature: <gateway namespace>/<gateway name>",
-		Severity: Unknown,
+		Code:        "KIA1108",
+		Message:     "Preferred nomenclature: <gateway namespace>/<gateway name>",
+		Severity:    Unknown,
+		ObjectTypes: []string{"virtualservice"},
+	},
+	"virtualservices.gateway.noroutes": {
+		Code:        "KIA1113",
+		Message:     "VirtualService bound to a gateway doesn't define any http, tcp or tls route",
+		Severity:    WarningSeverity,
+		ObjectTypes: []string{"virtualservice"},
+	},
+	"virtualservices.gateway.meshmissing": {
+		Code:        "KIA1114",
+		Message:     "VirtualService is not bound to the mesh: its rules don't apply to in-mesh clients of these hosts",
+		Severity:    InfoSeverity,
+		ObjectTypes: []string{"virtualservice"},
+	},
+	"virtualservices.delegate.notfound": {
+		Code:        "KIA1122",
+		Message:     "Delegate VirtualService not found",
+		Severity:    ErrorSeverity,
+		ObjectTypes: []string{"virtualservice"},
+	},
+	"virtualservices.delegate.routepresent": {
+		Code:        "KIA1123",
+		Message:     "An http route can't set both delegate and route",
+		Severity:    ErrorSeverity,
+		ObjectTypes: []string{"virtualservice"},
+	},
+	"virtualservices.gateway.hostnotadmitted": {
+		Code:        "KIA1115",
+		Message:     "None of the gateway server hosts admits the VirtualService hosts",
+		Severity:    WarningSeverity,
+		ObjectTypes: []string{"virtualservice"},
+	},
+	"virtualservices.gateway.hostnotmatched": {
+		Code:        "KIA1127",
+		Message:     "VirtualService host not matched by any server host of the bound gateways",
+		Severity:    WarningSeverity,
+		ObjectTypes: []string{"virtualservice"},
+	},
+	"virtualservices.gateway.passthroughhttproute": {
+		Code:        "KIA1125",
+		Message:     "Gateway servers pass TLS through for these hosts: http routes won't match, use tls routes with sniHosts",
+		Severity:    ErrorSeverity,
+		ObjectTypes: []string{"virtualservice"},
+	},
+	"virtualservices.gateway.terminatedtlsroute": {
+		Code:        "KIA1126",
+		Message:     "Gateway servers terminate TLS for these hosts: tls routes won't match, use http routes",
+		Severity:    ErrorSeverity,
+		ObjectTypes: []string{"virtualservice"},
+	},
+	"virtualservices.mirror.subsetnotfound": {
+		Code:        "KIA1129",
+		Message:     "Mirror subset not found: the mirrored traffic is dropped",
+		Severity:    ErrorSeverity,
+		ObjectTypes: []string{"virtualservice"},
+	},
+	"virtualservices.regex.invalid": {
+		Code:        "KIA1116",
+		Message:     "Invalid regular expression: it isn't RE2 compatible",
+		Severity:    WarningSeverity,
+		ObjectTypes: []string{"virtualservice"},
 	},
 	"virtualservices.nohost.hostnotfound": {
-		Code:     "KIA1101",
-		Message:  "DestinationWeight on route doesn't have a valid service (host not found)",
-		Severity: ErrorSeverity,
+		Code:        "KIA1101",
+		Message:     "DestinationWeight on route doesn't have a valid service (host not found)",
+		Severity:    ErrorSeverity,
+		ObjectTypes: []string{"virtualservice"},
 	},
 	"virtualservices.nogateway": {
-		Code:     "KIA1102",
-		Message:  "VirtualService is pointing to a non-existent gateway",
-		Severity: ErrorSeverity,
+		Code:        "KIA1102",
+		Message:     "VirtualService is pointing to a non-existent gateway",
+		Severity:    ErrorSeverity,
+		ObjectTypes: []string{"virtualservice"},
+	},
+	"virtualservices.nohost.mirrorhostnotfound": {
+		Code:        "KIA1112",
+		Message:     "Mirror destination host not found, mirrored traffic is dropped",
+		Severity:    ErrorSeverity,
+		ObjectTypes: []string{"virtualservice"},
 	},
 	"virtualservices.nohost.invalidprotocol": {
-		Code:     "KIA1103",
-		Message:  "VirtualService doesn't define any valid route protocol",
-		Severity: ErrorSeverity,
+		Code:        "KIA1103",
+		Message:     "VirtualService doesn't define any valid route protocol",
+		Severity:    ErrorSeverity,
+		ObjectTypes: []string{"virtualservice"},
 	},
 	"virtualservices.route.singleweight": {
-		Code:     "KIA1104",
-		Message:  "The weight is assumed to be 100 because there is only one route destination",
-		Severity: WarningSeverity,
+		Code:        "KIA1104",
+		Message:     "The weight is assumed to be 100 because there is only one route destination",
+		Severity:    WarningSeverity,
+		ObjectTypes: []string{"virtualservice"},
 	},
 	"virtualservices.route.repeatedsubset": {
-		Code:     "KIA1105",
-		Message:  "This subset is already referenced in another route destination",
-		Severity: WarningSeverity,
+		Code:        "KIA1105",
+		Message:     "This subset is already referenced in another route destination",
+		Severity:    WarningSeverity,
+		ObjectTypes: []string{"virtualservice"},
+	},
+	"virtualservices.route.duplicatedestination": {
+		Code:        "KIA1117",
+		Message:     "The same host and subset is listed in more than one route destination",
+		Severity:    WarningSeverity,
+		ObjectTypes: []string{"virtualservice"},
+	},
+	"virtualservices.route.weightssumlessthan100": {
+		Code:        "KIA1118",
+		Message:     "Weights of the route destinations sum less than 100",
+		Severity:    ErrorSeverity,
+		ObjectTypes: []string{"virtualservice"},
+	},
+	"virtualservices.route.weightssummorethan100": {
+		Code:        "KIA1119",
+		Message:     "Weights of the route destinations sum more than 100",
+		Severity:    ErrorSeverity,
+		ObjectTypes: []string{"virtualservice"},
+	},
+	"virtualservices.route.nonidempotentretries": {
+		Code:        "KIA1120",
+		Message:     "Retrying non-idempotent requests may cause duplicate side effects: restrict retryOn to connect-failure or refused-stream",
+		Severity:    InfoSeverity,
+		ObjectTypes: []string{"virtualservice"},
+	},
+	"virtualservices.subsetpresent.crossnamespacenotfound": {
+		Code:        "KIA1121",
+		Message:     "Subset not found in any DestinationRule of the host namespace",
+		Severity:    ErrorSeverity,
+		ObjectTypes: []string{"virtualservice"},
 	},
 	"virtualservices.singlehost": {
-		Code:     "KIA1106",
-		Message:  "More than one Virtual Service for same host",
-		Severity: WarningSeverity,
+		Code:        "KIA1106",
+		Message:     "More than one Virtual Service for same host",
+		Severity:    WarningSeverity,
+		ObjectTypes: []string{"virtualservice"},
+	},
+	"virtualservices.wildcardhost.mesh": {
+		Code:        "KIA1109",
+		Message:     "Wildcard host '*' applies to the whole mesh when not bound only to gateways",
+		Severity:    ErrorSeverity,
+		ObjectTypes: []string{"virtualservice"},
+	},
+	"virtualservices.wildcardhost.mixed": {
+		Code:        "KIA1124",
+		Message:     "Specific hosts are redundant along with the '*' host",
+		Severity:    WarningSeverity,
+		ObjectTypes: []string{"virtualservice"},
+	},
+	"virtualservices.route.tcponlyhost": {
+		Code:        "KIA1110",
+		Message:     "HTTP route settings have no effect: destination host only serves TCP or TLS traffic",
+		Severity:    InfoSeverity,
+		ObjectTypes: []string{"virtualservice"},
 	},
 	"virtualservices.subsetpresent.subsetnotfound": {
-		Code:     "KIA1107",
-		Message:  "Subset not found",
-		Severity: WarningSeverity,
+		Code:        "KIA1107",
+		Message:     "Subset not found",
+		Severity:    ErrorSeverity,
+		ObjectTypes: []string{"virtualservice"},
 	},
 	"validation.unable.cross-namespace": {
-		Code:     "KIA0001",
-		Message:  "Unable to verify the validity, cross-namespace validation is not supported for this field",
-		Severity: Unknown,
+		Code:        "KIA0001",
+		Message:     "Unable to verify the validity, cross-namespace validation is not supported for this field",
+		Severity:    Unknown,
+		ObjectTypes: []string{"authorizationpolicy", "sidecar", "virtualservice"},
+	},
+	"workloadentries.labels.nosubsetmatch": {
+		Code:        "KIA1601",
+		Message:     "Labels don't match any subset of the DestinationRules of the selecting services",
+		Severity:    WarningSeverity,
+		ObjectTypes: []string{"workloadentry"},
 	},
 }
 
 func Build(checkId string, path string) IstioCheck {
-	check := checkDescriptors[checkId]
-	check.Path = path
-	return check
+	descriptor := checkDescriptors[checkId]
+	return IstioCheck{
+		Code:     descriptor.Code,
+		Message:  descriptor.Message,
+		Severity: descriptor.Severity,
+		Path:     path,
+	}
+}
+
+// CheckDescriptors returns every check that validations can report, indexed by its message key
+func CheckDescriptors() map[string]IstioCheck {
+	descriptors := make(map[string]IstioCheck, len(checkDescriptors))
+	for key := range checkDescriptors {
+		descriptors[key] = Build(key, "")
+	}
+	return descriptors
+}
+
+// CheckObjectTypes returns the object types whose checkers report the check with the given message key
+func CheckObjectTypes(checkId string) []string {
+	return append([]string{}, checkDescriptors[checkId].ObjectTypes...)
+}
+
+// checkKeysByCode indexes the message keys of checkDescriptors by check code
+var checkKeysByCode = func() map[string]string {
+	keys := make(map[string]string, len(checkDescriptors))
+	for key, check := range checkDescriptors {
+		keys[check.Code] = key
+	}
+	return keys
+}()
+
+// CheckKey returns the message key of the check with the given code, e.g. "destinationrules.nodest.matchingregistry" for "KIA0202"
+func CheckKey(code string) (string, bool) {
+	key, found := checkKeysByCode[code]
+	return key, found
 }
 
 func BuildKey(objectType, name, namespace string) IstioValidationKey {
@@ -324,8 +831,8 @@ func BuildKey(objectType, name, namespace string) IstioValidationKey {
 }
 
 func CheckMessage(checkId string) string {
-	if val, ok := checkDescriptors[checkId]; ok {
-		return val.GetFullMessage()
+	if _, ok := checkDescriptors[checkId]; ok {
+		return Build(checkId, "").GetFullMessage()
 	} else {
 		return "ISTIO CHECK ID DOES NOT EXIST:" + checkId
 	}
@@ -411,6 +918,39 @@ func (iv IstioValidations) MergeValidations(validations IstioValidations) IstioV
 	return iv
 }
 
+// Diff returns the checks of iv that are not found in previous, grouped by validation key.
+// Keys without new checks are not included in the result.
+func (iv IstioValidations) Diff(previous IstioValidations) IstioValidations {
+	diff := IstioValidations{}
+	for key, validation := range iv {
+		prevValidation, found := previous[key]
+		newChecks := make([]*IstioCheck, 0)
+	NewCheck:
+		for _, check := range validation.Checks {
+			if found {
+				for _, prevCheck := range prevValidation.Checks {
+					if check.Path == prevCheck.Path &&
+						check.Severity == prevCheck.Severity &&
+						check.Message == prevCheck.Message {
+						continue NewCheck
+					}
+				}
+			}
+			newChecks = append(newChecks, check)
+		}
+		if len(newChecks) > 0 {
+			diff[key] = &IstioValidation{
+				Name:       validation.Name,
+				ObjectType: validation.ObjectType,
+				Valid:      validation.Valid,
+				Checks:     newChecks,
+				References: validation.References,
+			}
+		}
+	}
+	return diff
+}
+
 func (iv IstioValidations) MergeReferences(validations IstioValidations) IstioValidations {
 	for _, currentValidations := range iv {
 		if currentValidations.References == nil {
@@ -434,6 +974,25 @@ func (iv IstioValidations) SummarizeValidation(ns string) IstioValidationSummary
 	return ivs
 }
 
+// SummarizeMessageKeys returns the number of checks of the namespace objects by message key,
+// e.g. "destinationrules.nodest.matchingworkloads". Checks out of the catalog are counted by code.
+func (iv IstioValidations) SummarizeMessageKeys(ns string) map[string]int {
+	messageKeys := make(map[string]int)
+	for k, v := range iv {
+		if k.Namespace != ns {
+			continue
+		}
+		for _, c := range v.Checks {
+			if key, found := CheckKey(c.Code); found {
+				messageKeys[key]++
+			} else {
+				messageKeys[c.Code]++
+			}
+		}
+	}
+	return messageKeys
+}
+
 func (summary *IstioValidationSummary) mergeSummaries(cs []*IstioCheck) {
 	for _, c := range cs {
 		if c.Severity == ErrorSeverity {
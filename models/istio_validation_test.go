@@ -84,3 +84,80 @@ func TestSummarizeValidations(t *testing.T) {
 	assert.Equal(1, summary.Warnings)
 	assert.Equal(1, summary.Errors)
 }
+
+func TestCheckKey(t *testing.T) {
+	assert := assert.New(t)
+
+	key, found := CheckKey("KIA0202")
+	assert.True(found)
+	assert.Equal("destinationrules.nodest.matchingregistry", key)
+
+	for key, check := range checkDescriptors {
+		foundKey, found := CheckKey(check.Code)
+		assert.True(found)
+		assert.Equal(key, foundKey, "duplicate code %s", check.Code)
+	}
+
+	_, found = CheckKey("FOO1")
+	assert.False(found)
+}
+
+func TestSummarizeMessageKeys(t *testing.T) {
+	assert := assert.New(t)
+
+	validations := IstioValidations{
+		IstioValidationKey{ObjectType: "destinationrule", Name: "reviews", Namespace: "bookinfo"}: &IstioValidation{
+			Checks: []*IstioCheck{
+				{Code: "KIA0201", Severity: WarningSeverity},
+				{Code: "KIA0203", Severity: ErrorSeverity},
+			},
+		},
+		IstioValidationKey{ObjectType: "destinationrule", Name: "ratings", Namespace: "bookinfo"}: &IstioValidation{
+			Checks: []*IstioCheck{
+				{Code: "KIA0201", Severity: WarningSeverity},
+				{Code: "FOO1", Severity: ErrorSeverity},
+			},
+		},
+		IstioValidationKey{ObjectType: "destinationrule", Name: "details", Namespace: "other"}: &IstioValidation{
+			Checks: []*IstioCheck{
+				{Code: "KIA0202", Severity: ErrorSeverity},
+			},
+		},
+	}
+
+	messageKeys := validations.SummarizeMessageKeys("bookinfo")
+	assert.Len(messageKeys, 3)
+	assert.Equal(2, messageKeys["destinationrules.multimatch"])
+	assert.Equal(1, messageKeys["destinationrules.nodest.subsetlabels"])
+	assert.Equal(1, messageKeys["FOO1"])
+}
+
+func TestIstioValidationsDiff(t *testing.T) {
+	assert := assert.New(t)
+
+	key1 := IstioValidationKey{ObjectType: "destinationrule", Name: "reviews", Namespace: "bookinfo"}
+	key2 := IstioValidationKey{ObjectType: "destinationrule", Name: "ratings", Namespace: "bookinfo"}
+	key3 := IstioValidationKey{ObjectType: "virtualservice", Name: "reviews", Namespace: "bookinfo"}
+
+	multiMatch := &IstioCheck{Code: "KIA0201", Severity: WarningSeverity, Message: "Multi match", Path: "spec/host"}
+	subsetLabels := &IstioCheck{Code: "KIA0203", Severity: ErrorSeverity, Message: "Subset labels", Path: "spec/subsets[0]"}
+
+	previous := IstioValidations{
+		key1: &IstioValidation{Name: "reviews", ObjectType: "destinationrule", Valid: true, Checks: []*IstioCheck{multiMatch}},
+		key3: &IstioValidation{Name: "reviews", ObjectType: "virtualservice", Valid: true, Checks: []*IstioCheck{}},
+	}
+	current := IstioValidations{
+		key1: &IstioValidation{Name: "reviews", ObjectType: "destinationrule", Valid: false, Checks: []*IstioCheck{multiMatch, subsetLabels}},
+		key2: &IstioValidation{Name: "ratings", ObjectType: "destinationrule", Valid: true, Checks: []*IstioCheck{multiMatch}},
+		key3: &IstioValidation{Name: "reviews", ObjectType: "virtualservice", Valid: true, Checks: []*IstioCheck{}},
+	}
+
+	added := current.Diff(previous)
+	assert.Len(added, 2)
+	assert.Equal([]*IstioCheck{subsetLabels}, added[key1].Checks)
+	assert.False(added[key1].Valid)
+	assert.Equal([]*IstioCheck{multiMatch}, added[key2].Checks)
+
+	removed := previous.Diff(current)
+	assert.Empty(removed)
+}
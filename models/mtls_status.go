@@ -7,3 +7,27 @@ type MTLSStatus struct {
 	// example: MTLS_ENABLED
 	Status string `json:"status"`
 }
+
+// WorkloadSecurityPosture describes the effective mTLS and authorization settings applied to a workload
+type WorkloadSecurityPosture struct {
+	// Name of the workload
+	// required: true
+	// example: reviews-v1
+	Workload string `json:"workload"`
+
+	// Effective mTLS status: MTLS_ENABLED, MTLS_PARTIALLY_ENABLED, MTLS_NOT_ENABLED, MTLS_DISABLED
+	// required: true
+	// example: MTLS_ENABLED
+	MTLSStatus string `json:"mtlsStatus"`
+
+	// PeerAuthentications applied to the workload, in <namespace>/<name> form
+	PeerAuthentications []string `json:"peerAuthentications"`
+
+	// AuthorizationPolicies applied to the workload, in <namespace>/<name> form
+	AuthorizationPolicies []string `json:"authorizationPolicies"`
+
+	// True when the workload has a sidecar, strict mTLS and at least one AuthorizationPolicy
+	// required: true
+	// example: true
+	Protected bool `json:"protected"`
+}
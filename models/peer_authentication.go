@@ -41,3 +41,8 @@ func (pa *PeerAuthentication) Parse(peerAuthentication kubernetes.IstioObject) {
 	pa.Spec.Mtls = peerAuthentication.GetSpec()["mtls"]
 	pa.Spec.PortLevelMtls = peerAuthentication.GetSpec()["portLevelMtls"]
 }
+
+// ContentHash returns a hash of the PeerAuthentication spec. Identical specs produce identical hashes.
+func (pa *PeerAuthentication) ContentHash() string {
+	return contentHash(pa.Spec)
+}
@@ -28,6 +28,7 @@ type Pod struct {
 	VersionLabel        bool              `json:"versionLabel"`
 	Annotations         map[string]string `json:"annotations"`
 	ProxyStatus         *ProxyStatus      `json:"proxyStatus"`
+	ServiceAccountName  string            `json:"serviceAccountName"`
 }
 
 // Reference holds some information on the pod creator
@@ -68,6 +69,7 @@ func (pod *Pod) Parse(p *core_v1.Pod) {
 	pod.Name = p.Name
 	pod.Labels = p.Labels
 	pod.Annotations = p.Annotations
+	pod.ServiceAccountName = p.Spec.ServiceAccountName
 	pod.CreatedAt = formatTime(p.CreationTimestamp.Time)
 	for _, ref := range p.OwnerReferences {
 		pod.CreatedBy = append(pod.CreatedBy, Reference{
@@ -180,6 +182,19 @@ func (pods Pods) HasAnyIstioSidecar() bool {
 	return false
 }
 
+// ServiceAccounts returns the distinct service account names the pods run as
+func (pods Pods) ServiceAccounts() []string {
+	serviceAccounts := make([]string, 0)
+	seen := map[string]bool{}
+	for _, p := range pods {
+		if p.ServiceAccountName != "" && !seen[p.ServiceAccountName] {
+			seen[p.ServiceAccountName] = true
+			serviceAccounts = append(serviceAccounts, p.ServiceAccountName)
+		}
+	}
+	return serviceAccounts
+}
+
 // HasIstioSidecar returns true if the pod has an Istio proxy sidecar
 func (pod Pod) HasIstioSidecar() bool {
 	return len(pod.IstioContainers) > 0
@@ -26,6 +26,7 @@ func TestPodFullyParsing(t *testing.T) {
 			}},
 			Annotations: map[string]string{"sidecar.istio.io/status": "{\"version\":\"\",\"initContainers\":[\"istio-init\",\"enable-core-dump\"],\"containers\":[\"istio-proxy\"],\"volumes\":[\"istio-envoy\",\"istio-certs\"]}"}},
 		Spec: core_v1.PodSpec{
+			ServiceAccountName: "bookinfo-details",
 			Containers: []core_v1.Container{
 				{Name: "details", Image: "whatever"},
 				{Name: "istio-proxy", Image: "docker.io/istio/proxy:0.7.1"},
@@ -50,6 +51,21 @@ func TestPodFullyParsing(t *testing.T) {
 	assert.Equal("docker.io/istio/proxy_init:0.7.1", pod.IstioInitContainers[0].Image)
 	assert.Equal("enable-core-dump", pod.IstioInitContainers[1].Name)
 	assert.Equal("alpine", pod.IstioInitContainers[1].Image)
+	assert.Equal("bookinfo-details", pod.ServiceAccountName)
+}
+
+func TestPodsServiceAccounts(t *testing.T) {
+	assert := assert.New(t)
+
+	pods := Pods{
+		{Name: "details-v1-1", ServiceAccountName: "bookinfo-details"},
+		{Name: "details-v1-2", ServiceAccountName: "bookinfo-details"},
+		{Name: "details-v1-3"},
+		{Name: "details-v2-1", ServiceAccountName: "default"},
+	}
+
+	assert.Equal([]string{"bookinfo-details", "default"}, pods.ServiceAccounts())
+	assert.Empty(Pods{}.ServiceAccounts())
 }
 
 func TestPodParsingMissingImage(t *testing.T) {
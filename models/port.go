@@ -1,6 +1,14 @@
 package models
 
-import core_v1 "k8s.io/api/core/v1"
+import (
+	"strconv"
+	"strings"
+
+	core_v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/kiali/kiali/kubernetes"
+)
 
 type Ports []Port
 type Port struct {
@@ -36,3 +44,99 @@ func (port *Port) ParseEndpointPort(p core_v1.EndpointPort) {
 	port.Protocol = string(p.Protocol)
 	port.Port = p.Port
 }
+
+// Sources of an inbound port protocol
+const (
+	PortProtocolFromAppProtocol = "appProtocol"
+	PortProtocolFromAnnotation  = "annotation"
+	PortProtocolFromName        = "name"
+	PortProtocolFromTransport   = "transport"
+	PortProtocolDetected        = "detection"
+)
+
+// Pod annotations selecting the inbound ports intercepted by the sidecar
+const (
+	includeInboundPortsAnnotation = "traffic.sidecar.istio.io/includeInboundPorts"
+	excludeInboundPortsAnnotation = "traffic.sidecar.istio.io/excludeInboundPorts"
+)
+
+// InboundPortProtocol is the protocol Istio applies to the inbound traffic of a Service port
+type InboundPortProtocol struct {
+	Name     string `json:"name"`
+	Port     int32  `json:"port"`
+	Protocol string `json:"protocol"`
+	Source   string `json:"source"`
+}
+
+// ResolveInboundPortProtocols returns the effective protocol of each port of the Service, in spec order.
+// The appProtocol has precedence over the <protocol>[-suffix] port name. UDP and SCTP ports keep their transport
+// protocol, and the remaining ports are left to Istio protocol detection with an empty Protocol.
+func ResolveInboundPortProtocols(service core_v1.Service) []InboundPortProtocol {
+	return ResolveWorkloadInboundPortProtocols(service, nil)
+}
+
+// ResolveWorkloadInboundPortProtocols returns the effective protocol of each port of the Service for the pods
+// with the given annotations, in spec order. Target ports not intercepted by the sidecar, per the
+// traffic.sidecar.istio.io/includeInboundPorts and excludeInboundPorts annotations, bypass the proxy and are plain "tcp".
+// The remaining ports are resolved as in ResolveInboundPortProtocols.
+func ResolveWorkloadInboundPortProtocols(service core_v1.Service, annotations map[string]string) []InboundPortProtocol {
+	protocols := make([]InboundPortProtocol, 0, len(service.Spec.Ports))
+	for _, port := range service.Spec.Ports {
+		inbound := InboundPortProtocol{Name: port.Name, Port: port.Port}
+		isTransportTCP := port.Protocol == "" || port.Protocol == core_v1.ProtocolTCP
+		switch {
+		case isTransportTCP && !isInboundPortIntercepted(port, annotations):
+			inbound.Protocol, inbound.Source = "tcp", PortProtocolFromAnnotation
+		case port.AppProtocol != nil && *port.AppProtocol != "":
+			inbound.Protocol, inbound.Source = kubernetes.ServicePortProtocol(port), PortProtocolFromAppProtocol
+		case !isTransportTCP:
+			inbound.Protocol, inbound.Source = strings.ToLower(string(port.Protocol)), PortProtocolFromTransport
+		default:
+			inbound.Protocol, inbound.Source = kubernetes.ServicePortProtocol(port), PortProtocolFromName
+			if inbound.Protocol == "" {
+				inbound.Source = PortProtocolDetected
+			}
+		}
+		protocols = append(protocols, inbound)
+	}
+	return protocols
+}
+
+// InboundPortProtocols returns the effective protocol of each port of the Service for the workload, in spec order.
+// The sidecar annotations are read from the workload pods, which share the template annotations.
+func (workload *Workload) InboundPortProtocols(service core_v1.Service) []InboundPortProtocol {
+	var annotations map[string]string
+	if len(workload.Pods) > 0 {
+		annotations = workload.Pods[0].Annotations
+	}
+	return ResolveWorkloadInboundPortProtocols(service, annotations)
+}
+
+// isInboundPortIntercepted determines if the sidecar intercepts the target port of the Service port.
+// Named target ports can't be resolved without the container spec and are considered intercepted.
+func isInboundPortIntercepted(port core_v1.ServicePort, annotations map[string]string) bool {
+	if port.TargetPort.Type == intstr.String && port.TargetPort.StrVal != "" {
+		return true
+	}
+	targetPort := port.Port
+	if port.TargetPort.Type == intstr.Int && port.TargetPort.IntVal > 0 {
+		targetPort = port.TargetPort.IntVal
+	}
+
+	if include, found := annotations[includeInboundPortsAnnotation]; found && strings.TrimSpace(include) != "*" {
+		if !portListIncludes(include, targetPort) {
+			return false
+		}
+	}
+	return !portListIncludes(annotations[excludeInboundPortsAnnotation], targetPort)
+}
+
+// portListIncludes determines if the comma separated list of ports includes the port
+func portListIncludes(portList string, port int32) bool {
+	for _, p := range strings.Split(portList, ",") {
+		if strings.TrimSpace(p) == strconv.Itoa(int(port)) {
+			return true
+		}
+	}
+	return false
+}
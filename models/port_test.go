@@ -0,0 +1,77 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	core_v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestResolveInboundPortProtocols(t *testing.T) {
+	assert := assert.New(t)
+
+	grpcWeb, tcp := "grpc-web", "TCP"
+	service := core_v1.Service{
+		Spec: core_v1.ServiceSpec{
+			Ports: []core_v1.ServicePort{
+				{Name: "http-web", Port: 9080},
+				{Name: "grpc", Port: 9090},
+				{Name: "tcp-db", Port: 5432},
+				{Name: "http-api", Port: 8080, AppProtocol: &grpcWeb},
+				{Name: "web", Port: 8000, AppProtocol: &tcp},
+				{Name: "dns", Port: 53, Protocol: core_v1.ProtocolUDP},
+				{Name: "web", Port: 80},
+			},
+		},
+	}
+
+	assert.Equal([]InboundPortProtocol{
+		{Name: "http-web", Port: 9080, Protocol: "http", Source: PortProtocolFromName},
+		{Name: "grpc", Port: 9090, Protocol: "grpc", Source: PortProtocolFromName},
+		{Name: "tcp-db", Port: 5432, Protocol: "tcp", Source: PortProtocolFromName},
+		{Name: "http-api", Port: 8080, Protocol: "grpc-web", Source: PortProtocolFromAppProtocol},
+		{Name: "web", Port: 8000, Protocol: "tcp", Source: PortProtocolFromAppProtocol},
+		{Name: "dns", Port: 53, Protocol: "udp", Source: PortProtocolFromTransport},
+		{Name: "web", Port: 80, Protocol: "", Source: PortProtocolDetected},
+	}, ResolveInboundPortProtocols(service))
+}
+
+func TestResolveWorkloadInboundPortProtocols(t *testing.T) {
+	assert := assert.New(t)
+
+	grpc := "grpc"
+	service := core_v1.Service{
+		Spec: core_v1.ServiceSpec{
+			Ports: []core_v1.ServicePort{
+				{Name: "http-web", Port: 9080},
+				{Name: "grpc", Port: 9090, TargetPort: intstr.FromInt(19090), AppProtocol: &grpc},
+				{Name: "http-admin", Port: 8080, TargetPort: intstr.FromString("admin")},
+				{Name: "dns", Port: 53, Protocol: core_v1.ProtocolUDP},
+			},
+		},
+	}
+
+	workload := Workload{Pods: Pods{{Annotations: map[string]string{
+		"traffic.sidecar.istio.io/excludeInboundPorts": "15020, 19090",
+	}}}}
+	assert.Equal([]InboundPortProtocol{
+		{Name: "http-web", Port: 9080, Protocol: "http", Source: PortProtocolFromName},
+		{Name: "grpc", Port: 9090, Protocol: "tcp", Source: PortProtocolFromAnnotation},
+		{Name: "http-admin", Port: 8080, Protocol: "http", Source: PortProtocolFromName},
+		{Name: "dns", Port: 53, Protocol: "udp", Source: PortProtocolFromTransport},
+	}, workload.InboundPortProtocols(service))
+
+	workload = Workload{Pods: Pods{{Annotations: map[string]string{
+		"traffic.sidecar.istio.io/includeInboundPorts": "9080",
+	}}}}
+	assert.Equal([]InboundPortProtocol{
+		{Name: "http-web", Port: 9080, Protocol: "http", Source: PortProtocolFromName},
+		{Name: "grpc", Port: 9090, Protocol: "tcp", Source: PortProtocolFromAnnotation},
+		{Name: "http-admin", Port: 8080, Protocol: "http", Source: PortProtocolFromName},
+		{Name: "dns", Port: 53, Protocol: "udp", Source: PortProtocolFromTransport},
+	}, workload.InboundPortProtocols(service))
+
+	// Without pods every port is intercepted
+	assert.Equal(ResolveInboundPortProtocols(service), (&Workload{}).InboundPortProtocols(service))
+}
@@ -39,3 +39,8 @@ func (ra *RequestAuthentication) Parse(requestAuthentication kubernetes.IstioObj
 	ra.Spec.Selector = requestAuthentication.GetSpec()["selector"]
 	ra.Spec.JwtRules = requestAuthentication.GetSpec()["jwtRules"]
 }
+
+// ContentHash returns a hash of the RequestAuthentication spec. Identical specs produce identical hashes.
+func (ra *RequestAuthentication) ContentHash() string {
+	return contentHash(ra.Spec)
+}
@@ -2,6 +2,7 @@ package models
 
 import (
 	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/util/intutil"
 )
 
 type ServiceEntries []ServiceEntry
@@ -40,3 +41,106 @@ func (se *ServiceEntry) Parse(serviceEntry kubernetes.IstioObject) {
 	se.Spec.ExportTo = serviceEntry.GetSpec()["exportTo"]
 	se.Spec.SubjectAltNames = serviceEntry.GetSpec()["subjectAltNames"]
 }
+
+// ContentHash returns a hash of the ServiceEntry spec. Identical specs produce identical hashes.
+func (se *ServiceEntry) ContentHash() string {
+	return contentHash(se.Spec)
+}
+
+// ServiceEntryEndpoint is a typed view of a single entry of a ServiceEntry spec.endpoints
+type ServiceEntryEndpoint struct {
+	Address        string            `json:"address"`
+	Ports          map[string]uint32 `json:"ports,omitempty"`
+	Labels         map[string]string `json:"labels,omitempty"`
+	Network        string            `json:"network,omitempty"`
+	Locality       string            `json:"locality,omitempty"`
+	Weight         uint32            `json:"weight,omitempty"`
+	ServiceAccount string            `json:"serviceAccount,omitempty"`
+}
+
+// Endpoints returns the parsed endpoints of the ServiceEntry. Malformed entries are skipped.
+func (se *ServiceEntry) Endpoints() []ServiceEntryEndpoint {
+	endpoints := make([]ServiceEntryEndpoint, 0)
+	if se == nil {
+		return endpoints
+	}
+
+	if endpointsSpec, ok := se.Spec.Endpoints.([]interface{}); ok {
+		for _, endpointSpec := range endpointsSpec {
+			if endpointDef, ok := endpointSpec.(map[string]interface{}); ok {
+				endpoint := ServiceEntryEndpoint{}
+				endpoint.Address, _ = endpointDef["address"].(string)
+				endpoint.Network, _ = endpointDef["network"].(string)
+				endpoint.Locality, _ = endpointDef["locality"].(string)
+				endpoint.ServiceAccount, _ = endpointDef["serviceAccount"].(string)
+				if weight, err := intutil.Convert(endpointDef["weight"]); err == nil {
+					endpoint.Weight = uint32(weight)
+				}
+				if ports, ok := endpointDef["ports"].(map[string]interface{}); ok {
+					endpoint.Ports = make(map[string]uint32, len(ports))
+					for name, number := range ports {
+						if port, err := intutil.Convert(number); err == nil {
+							endpoint.Ports[name] = uint32(port)
+						}
+					}
+				}
+				if labels, ok := endpointDef["labels"].(map[string]interface{}); ok {
+					endpoint.Labels = make(map[string]string, len(labels))
+					for k, v := range labels {
+						if value, ok := v.(string); ok {
+							endpoint.Labels[k] = value
+						}
+					}
+				}
+				endpoints = append(endpoints, endpoint)
+			}
+		}
+	}
+
+	return endpoints
+}
+
+// IsResolvable returns true when the ServiceEntry hosts resolve to endpoints:
+// DNS resolution, or STATIC resolution with endpoints.
+func (se *ServiceEntry) IsResolvable() bool {
+	if se == nil {
+		return false
+	}
+
+	switch resolution, _ := se.Spec.Resolution.(string); resolution {
+	case "DNS", "DNS_ROUND_ROBIN":
+		return true
+	case "STATIC":
+		return len(se.Endpoints()) > 0
+	}
+	return false
+}
+
+// ExportedHosts returns the hosts of the ServiceEntry visible from the given namespace according to its exportTo.
+func (se *ServiceEntry) ExportedHosts(namespace string) []string {
+	hosts := make([]string, 0)
+	if se == nil {
+		return hosts
+	}
+
+	exportTo := make([]string, 0)
+	if exportToSpec, ok := se.Spec.ExportTo.([]interface{}); ok {
+		for _, value := range exportToSpec {
+			if ns, ok := value.(string); ok {
+				exportTo = append(exportTo, ns)
+			}
+		}
+	}
+	if !IsExportedTo(exportTo, se.Metadata.Namespace, namespace) {
+		return hosts
+	}
+
+	if hostsSpec, ok := se.Spec.Hosts.([]interface{}); ok {
+		for _, host := range hostsSpec {
+			if hostName, ok := host.(string); ok {
+				hosts = append(hosts, hostName)
+			}
+		}
+	}
+	return hosts
+}
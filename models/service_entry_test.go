@@ -0,0 +1,177 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/yaml"
+
+	"github.com/kiali/kiali/models"
+)
+
+func TestServiceEntryEndpoints(t *testing.T) {
+	assert := assert.New(t)
+
+	seYAML := []byte(`
+apiVersion: networking.istio.io/v1alpha3
+kind: ServiceEntry
+metadata:
+  name: external-svc-mongocluster
+spec:
+  hosts:
+  - mymongodb.somedomain
+  addresses:
+  - 192.192.192.192/24
+  ports:
+  - number: 27018
+    name: mongodb
+    protocol: MONGO
+  location: MESH_INTERNAL
+  resolution: STATIC
+  endpoints:
+  - address: 2.2.2.2
+    ports:
+      mongodb: 27019
+    labels:
+      app: mongo
+    network: network-1
+    locality: us-east1/us-east1-b
+  - address: 3.3.3.3
+    weight: 20
+`)
+
+	se := models.ServiceEntry{}
+	assert.NoError(yaml.Unmarshal(seYAML, &se))
+
+	endpoints := se.Endpoints()
+	assert.Len(endpoints, 2)
+
+	assert.Equal("2.2.2.2", endpoints[0].Address)
+	assert.Equal(map[string]uint32{"mongodb": 27019}, endpoints[0].Ports)
+	assert.Equal(map[string]string{"app": "mongo"}, endpoints[0].Labels)
+	assert.Equal("network-1", endpoints[0].Network)
+	assert.Equal("us-east1/us-east1-b", endpoints[0].Locality)
+
+	assert.Equal("3.3.3.3", endpoints[1].Address)
+	assert.Equal(uint32(20), endpoints[1].Weight)
+	assert.Nil(endpoints[1].Ports)
+	assert.Nil(endpoints[1].Labels)
+
+	// Testing nil case
+	var nilSE *models.ServiceEntry
+	assert.Empty(nilSE.Endpoints())
+}
+
+func TestServiceEntryIsResolvable(t *testing.T) {
+	cases := map[string]struct {
+		seYAML   []byte
+		expected bool
+	}{
+		"DNS resolution": {
+			expected: true,
+			seYAML: []byte(`
+apiVersion: networking.istio.io/v1beta1
+kind: ServiceEntry
+metadata:
+  name: wikipedia
+spec:
+  hosts:
+  - wikipedia.org
+  location: MESH_EXTERNAL
+  resolution: DNS
+`),
+		},
+		"NONE resolution": {
+			expected: false,
+			seYAML: []byte(`
+apiVersion: networking.istio.io/v1beta1
+kind: ServiceEntry
+metadata:
+  name: wikipedia
+spec:
+  hosts:
+  - wikipedia.org
+  location: MESH_EXTERNAL
+  resolution: NONE
+`),
+		},
+		"STATIC resolution without endpoints": {
+			expected: false,
+			seYAML: []byte(`
+apiVersion: networking.istio.io/v1beta1
+kind: ServiceEntry
+metadata:
+  name: mongo
+spec:
+  hosts:
+  - mymongodb.somedomain
+  location: MESH_INTERNAL
+  resolution: STATIC
+`),
+		},
+		"STATIC resolution with endpoints": {
+			expected: true,
+			seYAML: []byte(`
+apiVersion: networking.istio.io/v1beta1
+kind: ServiceEntry
+metadata:
+  name: mongo
+spec:
+  hosts:
+  - mymongodb.somedomain
+  location: MESH_INTERNAL
+  resolution: STATIC
+  endpoints:
+  - address: 2.2.2.2
+`),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			se := models.ServiceEntry{}
+			assert.NoError(yaml.Unmarshal(tc.seYAML, &se))
+
+			assert.Equal(tc.expected, se.IsResolvable())
+		})
+	}
+
+	// Testing nil case
+	var se *models.ServiceEntry
+	assert.False(t, se.IsResolvable())
+}
+
+func TestServiceEntryExportedHosts(t *testing.T) {
+	assert := assert.New(t)
+
+	seYAML := []byte(`
+apiVersion: networking.istio.io/v1beta1
+kind: ServiceEntry
+metadata:
+  name: wikipedia
+  namespace: bookinfo
+spec:
+  hosts:
+  - wikipedia.org
+  - en.wikipedia.org
+  exportTo:
+  - "."
+  location: MESH_EXTERNAL
+  resolution: DNS
+`)
+
+	se := models.ServiceEntry{}
+	assert.NoError(yaml.Unmarshal(seYAML, &se))
+
+	assert.Equal([]string{"wikipedia.org", "en.wikipedia.org"}, se.ExportedHosts("bookinfo"))
+	assert.Empty(se.ExportedHosts("travels"))
+
+	se.Spec.ExportTo = nil
+	assert.Equal([]string{"wikipedia.org", "en.wikipedia.org"}, se.ExportedHosts("travels"))
+
+	// Testing nil case
+	var nilSE *models.ServiceEntry
+	assert.Empty(nilSE.ExportedHosts("bookinfo"))
+}
@@ -32,3 +32,8 @@ func (sc *Sidecar) Parse(sidecar kubernetes.IstioObject) {
 	sc.Spec.OutboundTrafficPolicy = sidecar.GetSpec()["outboundTrafficPolicy"]
 	sc.Spec.Localhost = sidecar.GetSpec()["localhost"]
 }
+
+// ContentHash returns a hash of the Sidecar spec. Identical specs produce identical hashes.
+func (sc *Sidecar) ContentHash() string {
+	return contentHash(sc.Spec)
+}
@@ -1,7 +1,20 @@
 package models
 
-import "time"
+import (
+	"strings"
+	"time"
+)
 
 func formatTime(t time.Time) string {
 	return t.UTC().Format(time.RFC3339)
 }
+
+// ParseIstioDuration parses the duration format used by Istio APIs, i.e. "1.5s", "250ms" or "1h".
+// An empty duration is parsed as zero.
+func ParseIstioDuration(duration string) (time.Duration, error) {
+	duration = strings.TrimSpace(duration)
+	if duration == "" {
+		return 0, nil
+	}
+	return time.ParseDuration(duration)
+}
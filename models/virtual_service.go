@@ -1,7 +1,19 @@
 package models
 
 import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
 	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/util/intutil"
+)
+
+// Istio retry defaults applied to http routes without an explicit retry policy
+const (
+	DefaultRetryAttempts = 2
+	DefaultRetryOn       = "connect-failure,refused-stream,unavailable,cancelled,retriable-status-codes"
 )
 
 // VirtualServices virtualServices
@@ -16,6 +28,42 @@ type VirtualServices struct {
 	Items       []VirtualService    `json:"items"`
 }
 
+// HTTPRouteResilience is the effective timeout and retry configuration of an http route
+type HTTPRouteResilience struct {
+	// Request timeout. Zero means the timeout is disabled, which is the Istio default
+	Timeout time.Duration `json:"timeout"`
+
+	// Number of retries. Zero means retries are disabled
+	RetryAttempts int `json:"retryAttempts"`
+
+	// Timeout per retry attempt. When unset, each attempt is bounded by the request timeout
+	PerTryTimeout time.Duration `json:"perTryTimeout"`
+
+	// Conditions under which retries take place
+	RetryOn string `json:"retryOn"`
+}
+
+// RouteWeight is the weight of a route destination
+type RouteWeight struct {
+	// Destination host
+	Host string `json:"host"`
+
+	// Destination subset, empty when the destination doesn't set one
+	Subset string `json:"subset,omitempty"`
+
+	// Percentage of the route traffic sent to the destination
+	Weight int `json:"weight"`
+}
+
+// VirtualServiceEffectiveness tells whether a VirtualService can route any traffic
+type VirtualServiceEffectiveness struct {
+	// False when gateway or host issues prevent the VirtualService from routing traffic
+	Effective bool `json:"effective"`
+
+	// Checks explaining why the VirtualService is not effective
+	Reasons []*IstioCheck `json:"reasons"`
+}
+
 // VirtualService virtualService
 //
 // This type is used for returning a VirtualService
@@ -60,6 +108,11 @@ func (vService *VirtualService) Parse(virtualService kubernetes.IstioObject) {
 	}
 }
 
+// ContentHash returns a hash of the VirtualService spec. Identical specs produce identical hashes.
+func (vService *VirtualService) ContentHash() string {
+	return contentHash(vService.Spec)
+}
+
 // IsValidHost returns true if VirtualService hosts applies to the service
 func (vService *VirtualService) IsValidHost(namespace string, serviceName string) bool {
 	if serviceName == "" {
@@ -114,6 +167,312 @@ func (vService *VirtualService) HasFaultInjection() bool {
 	return false
 }
 
+// HasMirror determines if the spec has http traffic mirroring set,
+// either with the single mirror destination or the mirrors list.
+func (vService *VirtualService) HasMirror() bool {
+	if vService == nil {
+		return false
+	}
+
+	if routes, isSlice := vService.Spec.Http.([]interface{}); isSlice {
+		for _, route := range routes {
+			if routeMap, isMap := route.(map[string]interface{}); isMap {
+				if mirror, isMap := routeMap["mirror"].(map[string]interface{}); isMap && len(mirror) > 0 {
+					return true
+				}
+				if mirrors, isSlice := routeMap["mirrors"].([]interface{}); isSlice && len(mirrors) > 0 {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+// HasCORSPolicy determines if the spec has a non-empty http corsPolicy set.
+func (vService *VirtualService) HasCORSPolicy() bool {
+	if vService == nil {
+		return false
+	}
+
+	if routes, isSlice := vService.Spec.Http.([]interface{}); isSlice {
+		for _, route := range routes {
+			if routeMap, isMap := route.(map[string]interface{}); isMap {
+				if corsPolicy, isMap := routeMap["corsPolicy"].(map[string]interface{}); isMap && len(corsPolicy) > 0 {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+// HasRetries determines if the spec has http retries set with a positive number of attempts.
+func (vService *VirtualService) HasRetries() bool {
+	if vService == nil {
+		return false
+	}
+
+	if routes, isSlice := vService.Spec.Http.([]interface{}); isSlice {
+		for _, route := range routes {
+			if routeMap, isMap := route.(map[string]interface{}); isMap {
+				if retries, isMap := routeMap["retries"].(map[string]interface{}); isMap {
+					if attempts, err := intutil.Convert(retries["attempts"]); err == nil && attempts > 0 {
+						return true
+					}
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+// HasHeaderManipulation determines if the spec sets, adds or removes request or response headers,
+// either at the http route level or at any of its route destinations.
+func (vService *VirtualService) HasHeaderManipulation() bool {
+	if vService == nil {
+		return false
+	}
+
+	if routes, isSlice := vService.Spec.Http.([]interface{}); isSlice {
+		for _, route := range routes {
+			if routeMap, isMap := route.(map[string]interface{}); isMap {
+				if isHeaderManipulation(routeMap["headers"]) {
+					return true
+				}
+				if destinations, isSlice := routeMap["route"].([]interface{}); isSlice {
+					for _, destination := range destinations {
+						if destinationMap, isMap := destination.(map[string]interface{}); isMap && isHeaderManipulation(destinationMap["headers"]) {
+							return true
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+func isHeaderManipulation(headers interface{}) bool {
+	if headersMap, isMap := headers.(map[string]interface{}); isMap {
+		for _, direction := range []string{"request", "response"} {
+			if operations, isMap := headersMap[direction].(map[string]interface{}); isMap {
+				for _, operation := range []string{"set", "add"} {
+					if values, isMap := operations[operation].(map[string]interface{}); isMap && len(values) > 0 {
+						return true
+					}
+				}
+				if remove, isSlice := operations["remove"].([]interface{}); isSlice && len(remove) > 0 {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// HasRedirect determines if the spec has any http route with a redirect set.
+func (vService *VirtualService) HasRedirect() bool {
+	return vService.hasHTTPRouteSetting("redirect")
+}
+
+// HasRewrite determines if the spec has any http route with a rewrite set.
+func (vService *VirtualService) HasRewrite() bool {
+	return vService.hasHTTPRouteSetting("rewrite")
+}
+
+// HasDirectResponse determines if the spec has any http route with a directResponse set.
+func (vService *VirtualService) HasDirectResponse() bool {
+	return vService.hasHTTPRouteSetting("directResponse")
+}
+
+func (vService *VirtualService) hasHTTPRouteSetting(setting string) bool {
+	if vService == nil {
+		return false
+	}
+
+	if routes, isSlice := vService.Spec.Http.([]interface{}); isSlice {
+		for _, route := range routes {
+			if routeMap, isMap := route.(map[string]interface{}); isMap {
+				if value, isMap := routeMap[setting].(map[string]interface{}); isMap && len(value) > 0 {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+// GatewayNames returns the sorted set of gateways the VirtualService is bound to, in the <namespace>/<name> form.
+// Gateways without namespace belong to the VirtualService namespace. The reserved "mesh" gateway is kept as is
+// and it's implied when no gateways are set.
+func (vService *VirtualService) GatewayNames() []string {
+	names := make([]string, 0)
+	if vService == nil {
+		return names
+	}
+
+	gateways, _ := vService.Spec.Gateways.([]interface{})
+	if len(gateways) == 0 {
+		return append(names, "mesh")
+	}
+
+	seen := make(map[string]bool, len(gateways))
+	for _, gateway := range gateways {
+		gwName, ok := gateway.(string)
+		if !ok {
+			continue
+		}
+		if gwName != "mesh" {
+			gwHost := kubernetes.ParseGatewayAsHost(gwName, vService.Metadata.Namespace, "")
+			gwName = gwHost.Service
+			if gwHost.Namespace != "" {
+				gwName = gwHost.Namespace + "/" + gwHost.Service
+			}
+		}
+		if !seen[gwName] {
+			seen[gwName] = true
+			names = append(names, gwName)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// HasMeshGateway determines if the VirtualService applies to the sidecars of the mesh,
+// either explicitly through the "mesh" gateway or implicitly by not setting any gateway.
+func (vService *VirtualService) HasMeshGateway() bool {
+	for _, name := range vService.GatewayNames() {
+		if name == "mesh" {
+			return true
+		}
+	}
+	return false
+}
+
+// ResolvedHosts returns the hosts of the VirtualService, in spec order, with the short names resolved to the
+// FQDN of the service in the given namespace (e.g. reviews.bookinfo.svc.cluster.local).
+// Wildcards, FQDNs and external hostnames are kept as is.
+func (vService *VirtualService) ResolvedHosts(namespace string) []string {
+	resolved := make([]string, 0)
+	if vService == nil {
+		return resolved
+	}
+
+	for _, hostName := range vService.Spec.Hosts {
+		if !strings.HasPrefix(hostName, "*") {
+			hostName = kubernetes.ParseHost(hostName, namespace, "").String()
+		}
+		resolved = append(resolved, hostName)
+	}
+	return resolved
+}
+
+// EffectiveHTTPResilience returns the timeout and retry configuration of each http route,
+// in spec order, with the Istio defaults applied to the settings that are not set.
+func (vService *VirtualService) EffectiveHTTPResilience() ([]HTTPRouteResilience, error) {
+	resilience := make([]HTTPRouteResilience, 0)
+	if vService == nil {
+		return resilience, nil
+	}
+
+	routes, isSlice := vService.Spec.Http.([]interface{})
+	if !isSlice {
+		return resilience, nil
+	}
+
+	for i, route := range routes {
+		routeMap, isMap := route.(map[string]interface{})
+		if !isMap {
+			continue
+		}
+		routeResilience := HTTPRouteResilience{
+			RetryAttempts: DefaultRetryAttempts,
+			RetryOn:       DefaultRetryOn,
+		}
+
+		if timeout, isString := routeMap["timeout"].(string); isString {
+			parsed, err := ParseIstioDuration(timeout)
+			if err != nil {
+				return nil, fmt.Errorf("invalid timeout in http[%d]: %s", i, err)
+			}
+			routeResilience.Timeout = parsed
+		}
+
+		if retries, isMap := routeMap["retries"].(map[string]interface{}); isMap {
+			if attempts, found := retries["attempts"]; found {
+				if value, err := intutil.Convert(attempts); err == nil {
+					routeResilience.RetryAttempts = value
+				}
+			}
+			if perTryTimeout, isString := retries["perTryTimeout"].(string); isString {
+				parsed, err := ParseIstioDuration(perTryTimeout)
+				if err != nil {
+					return nil, fmt.Errorf("invalid retries perTryTimeout in http[%d]: %s", i, err)
+				}
+				routeResilience.PerTryTimeout = parsed
+			}
+			if retryOn, isString := retries["retryOn"].(string); isString && retryOn != "" {
+				routeResilience.RetryOn = retryOn
+			}
+		}
+
+		if routeResilience.RetryAttempts == 0 {
+			routeResilience.PerTryTimeout = 0
+			routeResilience.RetryOn = ""
+		} else if routeResilience.PerTryTimeout == 0 {
+			routeResilience.PerTryTimeout = routeResilience.Timeout
+		}
+
+		resilience = append(resilience, routeResilience)
+	}
+
+	return resilience, nil
+}
+
+// Weights returns the weight of each destination of the http, tcp and tls routes, in spec order.
+// A single destination without weight takes the 100% of its route traffic.
+func (vService *VirtualService) Weights() []RouteWeight {
+	if vService == nil {
+		return nil
+	}
+
+	weights := make([]RouteWeight, 0)
+	for _, protocolRoutes := range []interface{}{vService.Spec.Http, vService.Spec.Tcp, vService.Spec.Tls} {
+		routes, _ := protocolRoutes.([]interface{})
+		for _, route := range routes {
+			routeMap, isMap := route.(map[string]interface{})
+			if !isMap {
+				continue
+			}
+			destinations, _ := routeMap["route"].([]interface{})
+			for _, destination := range destinations {
+				destinationMap, isMap := destination.(map[string]interface{})
+				if !isMap {
+					continue
+				}
+				routeWeight := RouteWeight{}
+				if dest, isMap := destinationMap["destination"].(map[string]interface{}); isMap {
+					routeWeight.Host, _ = dest["host"].(string)
+					routeWeight.Subset, _ = dest["subset"].(string)
+				}
+				if weight, found := destinationMap["weight"]; found {
+					routeWeight.Weight, _ = intutil.Convert(weight)
+				} else if len(destinations) == 1 {
+					routeWeight.Weight = 100
+				}
+				weights = append(weights, routeWeight)
+			}
+		}
+	}
+	return weights
+}
+
 // HasTrafficShifting determines if the spec has http traffic shifting set.
 // If there are routes with multiple destinations then it is assumed that
 // the spec has traffic shifting regardless of weights.
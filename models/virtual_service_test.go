@@ -2,10 +2,12 @@ package models_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"k8s.io/apimachinery/pkg/util/yaml"
 
+	"github.com/kiali/kiali/config"
 	"github.com/kiali/kiali/models"
 )
 
@@ -520,3 +522,894 @@ spec:
 	var vs *models.VirtualService
 	assert.False(t, vs.HasRequestRouting())
 }
+
+func TestVirtualServiceHasMirror(t *testing.T) {
+	cases := map[string]struct {
+		vsYAML            []byte
+		expectedMirroring bool
+	}{
+		"Has mirror": {
+			expectedMirroring: true,
+			vsYAML: []byte(`
+apiVersion: networking.istio.io/v1beta1
+kind: VirtualService
+metadata:
+  name: httpbin
+spec:
+  hosts:
+  - httpbin
+  http:
+  - route:
+    - destination:
+        host: httpbin
+        subset: v1
+    mirror:
+      host: httpbin
+      subset: v2
+`),
+		},
+		"Has multiple mirrors": {
+			expectedMirroring: true,
+			vsYAML: []byte(`
+apiVersion: networking.istio.io/v1beta1
+kind: VirtualService
+metadata:
+  name: httpbin
+spec:
+  hosts:
+  - httpbin
+  http:
+  - route:
+    - destination:
+        host: httpbin
+        subset: v1
+    mirrors:
+    - destination:
+        host: httpbin
+        subset: v2
+    - destination:
+        host: httpbin-shadow
+      percentage:
+        value: 50
+`),
+		},
+		"Mirror only in second route": {
+			expectedMirroring: true,
+			vsYAML: []byte(`
+apiVersion: networking.istio.io/v1beta1
+kind: VirtualService
+metadata:
+  name: httpbin
+spec:
+  hosts:
+  - httpbin
+  http:
+  - match:
+    - uri:
+        prefix: /status
+    route:
+    - destination:
+        host: httpbin
+        subset: v1
+  - route:
+    - destination:
+        host: httpbin
+        subset: v1
+    mirror:
+      host: httpbin
+      subset: v2
+`),
+		},
+		"Empty mirrors": {
+			expectedMirroring: false,
+			vsYAML: []byte(`
+apiVersion: networking.istio.io/v1beta1
+kind: VirtualService
+metadata:
+  name: httpbin
+spec:
+  hosts:
+  - httpbin
+  http:
+  - route:
+    - destination:
+        host: httpbin
+        subset: v1
+    mirrors: []
+`),
+		},
+		"No mirror": {
+			expectedMirroring: false,
+			vsYAML: []byte(`
+apiVersion: networking.istio.io/v1beta1
+kind: VirtualService
+metadata:
+  name: httpbin
+spec:
+  hosts:
+  - httpbin
+  http:
+  - route:
+    - destination:
+        host: httpbin
+        subset: v1
+`),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			var vs models.VirtualService
+			assert.NoError(yaml.Unmarshal(tc.vsYAML, &vs))
+
+			assert.Equal(tc.expectedMirroring, vs.HasMirror())
+		})
+	}
+
+	// Testing nil case
+	var vs *models.VirtualService
+	assert.False(t, vs.HasMirror())
+}
+
+func TestVirtualServiceHasCORSPolicy(t *testing.T) {
+	cases := map[string]struct {
+		vsYAML       []byte
+		expectedCORS bool
+	}{
+		"Only allowOrigins": {
+			expectedCORS: true,
+			vsYAML: []byte(`
+apiVersion: networking.istio.io/v1beta1
+kind: VirtualService
+metadata:
+  name: ratings
+spec:
+  hosts:
+  - ratings
+  http:
+  - route:
+    - destination:
+        host: ratings
+        subset: v1
+    corsPolicy:
+      allowOrigins:
+      - exact: https://example.com
+`),
+		},
+		"Empty corsPolicy": {
+			expectedCORS: false,
+			vsYAML: []byte(`
+apiVersion: networking.istio.io/v1beta1
+kind: VirtualService
+metadata:
+  name: ratings
+spec:
+  hosts:
+  - ratings
+  http:
+  - route:
+    - destination:
+        host: ratings
+        subset: v1
+    corsPolicy: {}
+`),
+		},
+		"CORS only in second route": {
+			expectedCORS: true,
+			vsYAML: []byte(`
+apiVersion: networking.istio.io/v1beta1
+kind: VirtualService
+metadata:
+  name: ratings
+spec:
+  hosts:
+  - ratings
+  http:
+  - match:
+    - headers:
+        end-user:
+          exact: jason
+    route:
+    - destination:
+        host: ratings
+        subset: v2
+  - route:
+    - destination:
+        host: ratings
+        subset: v1
+    corsPolicy:
+      allowMethods:
+      - GET
+      - POST
+      allowHeaders:
+      - X-Foo-Bar
+      maxAge: 24h
+`),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			var vs models.VirtualService
+			assert.NoError(yaml.Unmarshal(tc.vsYAML, &vs))
+
+			assert.Equal(tc.expectedCORS, vs.HasCORSPolicy())
+		})
+	}
+
+	// Testing nil case
+	var vs *models.VirtualService
+	assert.False(t, vs.HasCORSPolicy())
+}
+
+func TestVirtualServiceHasRetries(t *testing.T) {
+	cases := map[string]struct {
+		vsYAML          []byte
+		expectedRetries bool
+	}{
+		"Single retry block": {
+			expectedRetries: true,
+			vsYAML: []byte(`
+apiVersion: networking.istio.io/v1beta1
+kind: VirtualService
+metadata:
+  name: ratings
+spec:
+  hosts:
+  - ratings
+  http:
+  - route:
+    - destination:
+        host: ratings
+        subset: v1
+    retries:
+      attempts: 3
+      perTryTimeout: 2s
+`),
+		},
+		"Retries only in second route": {
+			expectedRetries: true,
+			vsYAML: []byte(`
+apiVersion: networking.istio.io/v1beta1
+kind: VirtualService
+metadata:
+  name: ratings
+spec:
+  hosts:
+  - ratings
+  http:
+  - match:
+    - headers:
+        end-user:
+          exact: jason
+    route:
+    - destination:
+        host: ratings
+        subset: v2
+  - route:
+    - destination:
+        host: ratings
+        subset: v1
+    retries:
+      attempts: 2
+      retryOn: gateway-error,connect-failure
+`),
+		},
+		"Retries disabled": {
+			expectedRetries: false,
+			vsYAML: []byte(`
+apiVersion: networking.istio.io/v1beta1
+kind: VirtualService
+metadata:
+  name: ratings
+spec:
+  hosts:
+  - ratings
+  http:
+  - route:
+    - destination:
+        host: ratings
+        subset: v1
+    retries:
+      attempts: 0
+`),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			var vs models.VirtualService
+			assert.NoError(yaml.Unmarshal(tc.vsYAML, &vs))
+
+			assert.Equal(tc.expectedRetries, vs.HasRetries())
+		})
+	}
+
+	// Testing nil case
+	var vs *models.VirtualService
+	assert.False(t, vs.HasRetries())
+}
+
+func TestVirtualServiceEffectiveHTTPResilience(t *testing.T) {
+	assert := assert.New(t)
+
+	vsYAML := []byte(`
+apiVersion: networking.istio.io/v1beta1
+kind: VirtualService
+metadata:
+  name: ratings
+spec:
+  hosts:
+  - ratings
+  http:
+  - match:
+    - headers:
+        end-user:
+          exact: jason
+    timeout: 10s
+    retries:
+      attempts: 3
+      perTryTimeout: 2s
+      retryOn: 5xx
+    route:
+    - destination:
+        host: ratings
+        subset: v2
+  - timeout: 1.5s
+    route:
+    - destination:
+        host: ratings
+        subset: v1
+  - retries:
+      attempts: 0
+    route:
+    - destination:
+        host: ratings
+        subset: v1
+  - route:
+    - destination:
+        host: ratings
+        subset: v1
+`)
+
+	var vs models.VirtualService
+	assert.NoError(yaml.Unmarshal(vsYAML, &vs))
+
+	resilience, err := vs.EffectiveHTTPResilience()
+	assert.NoError(err)
+	assert.Len(resilience, 4)
+
+	// Explicit values
+	assert.Equal(models.HTTPRouteResilience{Timeout: 10 * time.Second, RetryAttempts: 3, PerTryTimeout: 2 * time.Second, RetryOn: "5xx"}, resilience[0])
+	// Default retries bounded by the route timeout
+	assert.Equal(models.HTTPRouteResilience{Timeout: 1500 * time.Millisecond, RetryAttempts: models.DefaultRetryAttempts, PerTryTimeout: 1500 * time.Millisecond, RetryOn: models.DefaultRetryOn}, resilience[1])
+	// Retries disabled
+	assert.Equal(models.HTTPRouteResilience{}, resilience[2])
+	// Istio defaults
+	assert.Equal(models.HTTPRouteResilience{RetryAttempts: models.DefaultRetryAttempts, RetryOn: models.DefaultRetryOn}, resilience[3])
+
+	vs.Spec.Http.([]interface{})[1].(map[string]interface{})["timeout"] = "soon"
+	_, err = vs.EffectiveHTTPResilience()
+	assert.Error(err)
+
+	// Testing nil case
+	var nilVS *models.VirtualService
+	resilience, err = nilVS.EffectiveHTTPResilience()
+	assert.NoError(err)
+	assert.Empty(resilience)
+}
+
+func TestVirtualServiceHasHeaderManipulation(t *testing.T) {
+	cases := map[string]struct {
+		vsYAML                    []byte
+		expectedHeaderManipulated bool
+	}{
+		"Request add": {
+			expectedHeaderManipulated: true,
+			vsYAML: []byte(`
+apiVersion: networking.istio.io/v1beta1
+kind: VirtualService
+metadata:
+  name: reviews
+spec:
+  hosts:
+  - reviews
+  http:
+  - headers:
+      request:
+        add:
+          x-request-source: kiali
+    route:
+    - destination:
+        host: reviews
+`),
+		},
+		"Response remove": {
+			expectedHeaderManipulated: true,
+			vsYAML: []byte(`
+apiVersion: networking.istio.io/v1beta1
+kind: VirtualService
+metadata:
+  name: reviews
+spec:
+  hosts:
+  - reviews
+  http:
+  - headers:
+      response:
+        remove:
+        - x-envoy-upstream-service-time
+    route:
+    - destination:
+        host: reviews
+`),
+		},
+		"Destination level": {
+			expectedHeaderManipulated: true,
+			vsYAML: []byte(`
+apiVersion: networking.istio.io/v1beta1
+kind: VirtualService
+metadata:
+  name: reviews
+spec:
+  hosts:
+  - reviews
+  http:
+  - route:
+    - destination:
+        host: reviews
+        subset: v1
+      weight: 50
+    - destination:
+        host: reviews
+        subset: v2
+      weight: 50
+      headers:
+        request:
+          set:
+            x-version: v2
+`),
+		},
+		"None": {
+			expectedHeaderManipulated: false,
+			vsYAML: []byte(`
+apiVersion: networking.istio.io/v1beta1
+kind: VirtualService
+metadata:
+  name: reviews
+spec:
+  hosts:
+  - reviews
+  http:
+  - match:
+    - headers:
+        end-user:
+          exact: jason
+    route:
+    - destination:
+        host: reviews
+`),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			var vs models.VirtualService
+			assert.NoError(yaml.Unmarshal(tc.vsYAML, &vs))
+
+			assert.Equal(tc.expectedHeaderManipulated, vs.HasHeaderManipulation())
+		})
+	}
+
+	// Testing nil case
+	var vs *models.VirtualService
+	assert.False(t, vs.HasHeaderManipulation())
+}
+
+func TestVirtualServiceHasRedirectAndRewrite(t *testing.T) {
+	cases := map[string]struct {
+		vsYAML           []byte
+		expectedRedirect bool
+		expectedRewrite  bool
+	}{
+		"Redirect only": {
+			expectedRedirect: true,
+			expectedRewrite:  false,
+			vsYAML: []byte(`
+apiVersion: networking.istio.io/v1beta1
+kind: VirtualService
+metadata:
+  name: httpbin
+spec:
+  hosts:
+  - httpbin
+  http:
+  - match:
+    - uri:
+        exact: /v1/status
+    redirect:
+      uri: /v2/status
+`),
+		},
+		"Rewrite only": {
+			expectedRedirect: false,
+			expectedRewrite:  true,
+			vsYAML: []byte(`
+apiVersion: networking.istio.io/v1beta1
+kind: VirtualService
+metadata:
+  name: httpbin
+spec:
+  hosts:
+  - httpbin
+  http:
+  - match:
+    - uri:
+        prefix: /api
+    rewrite:
+      uri: /
+    route:
+    - destination:
+        host: httpbin
+`),
+		},
+		"Redirect and rewrite": {
+			expectedRedirect: true,
+			expectedRewrite:  true,
+			vsYAML: []byte(`
+apiVersion: networking.istio.io/v1beta1
+kind: VirtualService
+metadata:
+  name: httpbin
+spec:
+  hosts:
+  - httpbin
+  http:
+  - match:
+    - uri:
+        exact: /old
+    redirect:
+      uri: /new
+  - rewrite:
+      authority: httpbin.internal
+    route:
+    - destination:
+        host: httpbin
+`),
+		},
+		"Plain route": {
+			expectedRedirect: false,
+			expectedRewrite:  false,
+			vsYAML: []byte(`
+apiVersion: networking.istio.io/v1beta1
+kind: VirtualService
+metadata:
+  name: httpbin
+spec:
+  hosts:
+  - httpbin
+  http:
+  - route:
+    - destination:
+        host: httpbin
+`),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			var vs models.VirtualService
+			assert.NoError(yaml.Unmarshal(tc.vsYAML, &vs))
+
+			assert.Equal(tc.expectedRedirect, vs.HasRedirect())
+			assert.Equal(tc.expectedRewrite, vs.HasRewrite())
+		})
+	}
+
+	// Testing nil case
+	var vs *models.VirtualService
+	assert.False(t, vs.HasRedirect())
+	assert.False(t, vs.HasRewrite())
+}
+
+func TestVirtualServiceGatewayNames(t *testing.T) {
+	cases := map[string]struct {
+		vsYAML          []byte
+		expectedNames   []string
+		expectedHasMesh bool
+	}{
+		"Explicit gateways": {
+			expectedNames:   []string{"bookinfo/bookinfo-gateway", "istio-system/ingress"},
+			expectedHasMesh: false,
+			vsYAML: []byte(`
+apiVersion: networking.istio.io/v1beta1
+kind: VirtualService
+metadata:
+  name: bookinfo
+  namespace: bookinfo
+spec:
+  hosts:
+  - "*"
+  gateways:
+  - istio-system/ingress
+  - bookinfo-gateway
+  - bookinfo/bookinfo-gateway
+  http:
+  - route:
+    - destination:
+        host: productpage
+`),
+		},
+		"Empty gateways": {
+			expectedNames:   []string{"mesh"},
+			expectedHasMesh: true,
+			vsYAML: []byte(`
+apiVersion: networking.istio.io/v1beta1
+kind: VirtualService
+metadata:
+  name: reviews
+  namespace: bookinfo
+spec:
+  hosts:
+  - reviews
+  http:
+  - route:
+    - destination:
+        host: reviews
+`),
+		},
+		"Mesh and named gateway": {
+			expectedNames:   []string{"bookinfo/bookinfo-gateway", "mesh"},
+			expectedHasMesh: true,
+			vsYAML: []byte(`
+apiVersion: networking.istio.io/v1beta1
+kind: VirtualService
+metadata:
+  name: reviews
+  namespace: bookinfo
+spec:
+  hosts:
+  - reviews
+  gateways:
+  - mesh
+  - bookinfo-gateway
+  http:
+  - route:
+    - destination:
+        host: reviews
+`),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			var vs models.VirtualService
+			assert.NoError(yaml.Unmarshal(tc.vsYAML, &vs))
+
+			assert.Equal(tc.expectedNames, vs.GatewayNames())
+			assert.Equal(tc.expectedHasMesh, vs.HasMeshGateway())
+		})
+	}
+
+	// Testing nil case
+	var vs *models.VirtualService
+	assert.Empty(t, vs.GatewayNames())
+	assert.False(t, vs.HasMeshGateway())
+}
+
+func TestVirtualServiceResolvedHosts(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vsYAML := []byte(`
+apiVersion: networking.istio.io/v1beta1
+kind: VirtualService
+metadata:
+  name: bookinfo
+  namespace: bookinfo
+spec:
+  hosts:
+  - reviews
+  - ratings.bookinfo.svc.cluster.local
+  - "*.bookinfo.svc.cluster.local"
+  - "*"
+  - www.example.com
+  http:
+  - route:
+    - destination:
+        host: reviews
+`)
+
+	var vs models.VirtualService
+	assert.NoError(yaml.Unmarshal(vsYAML, &vs))
+
+	assert.Equal([]string{
+		"reviews.bookinfo.svc.cluster.local",
+		"ratings.bookinfo.svc.cluster.local",
+		"*.bookinfo.svc.cluster.local",
+		"*",
+		"www.example.com",
+	}, vs.ResolvedHosts("bookinfo"))
+
+	// Testing nil case
+	var nilVS *models.VirtualService
+	assert.Empty(nilVS.ResolvedHosts("bookinfo"))
+}
+
+func TestVirtualServiceWeights(t *testing.T) {
+	cases := map[string]struct {
+		vsYAML   []byte
+		expected []models.RouteWeight
+	}{
+		"Http split": {
+			expected: []models.RouteWeight{
+				{Host: "reviews", Subset: "v1", Weight: 25},
+				{Host: "reviews", Subset: "v2", Weight: 75},
+			},
+			vsYAML: []byte(`
+apiVersion: networking.istio.io/v1beta1
+kind: VirtualService
+metadata:
+  name: reviews
+spec:
+  hosts:
+  - reviews
+  http:
+  - route:
+    - destination:
+        host: reviews
+        subset: v1
+      weight: 25
+    - destination:
+        host: reviews
+        subset: v2
+      weight: 75
+`),
+		},
+		"Tcp split": {
+			expected: []models.RouteWeight{
+				{Host: "mongo.backup.svc.cluster.local", Weight: 20},
+				{Host: "mongo", Weight: 80},
+			},
+			vsYAML: []byte(`
+apiVersion: networking.istio.io/v1beta1
+kind: VirtualService
+metadata:
+  name: mongo
+spec:
+  hosts:
+  - mongo
+  tcp:
+  - match:
+    - port: 27017
+    route:
+    - destination:
+        host: mongo.backup.svc.cluster.local
+      weight: 20
+    - destination:
+        host: mongo
+      weight: 80
+`),
+		},
+		"Single destination": {
+			expected: []models.RouteWeight{
+				{Host: "ratings", Subset: "v1", Weight: 100},
+			},
+			vsYAML: []byte(`
+apiVersion: networking.istio.io/v1beta1
+kind: VirtualService
+metadata:
+  name: ratings
+spec:
+  hosts:
+  - ratings
+  http:
+  - route:
+    - destination:
+        host: ratings
+        subset: v1
+`),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			var vs models.VirtualService
+			assert.NoError(yaml.Unmarshal(tc.vsYAML, &vs))
+
+			assert.Equal(tc.expected, vs.Weights())
+		})
+	}
+
+	// Testing nil case
+	var vs *models.VirtualService
+	assert.Nil(t, vs.Weights())
+}
+
+func TestVirtualServiceHasDirectResponse(t *testing.T) {
+	cases := map[string]struct {
+		vsYAML   []byte
+		expected bool
+	}{
+		"Direct response": {
+			expected: true,
+			vsYAML: []byte(`
+apiVersion: networking.istio.io/v1beta1
+kind: VirtualService
+metadata:
+  name: maintenance
+spec:
+  hosts:
+  - bookinfo.example.com
+  http:
+  - directResponse:
+      status: 503
+      body:
+        string: "Under maintenance"
+`),
+		},
+		"Direct response and route": {
+			expected: true,
+			vsYAML: []byte(`
+apiVersion: networking.istio.io/v1beta1
+kind: VirtualService
+metadata:
+  name: maintenance
+spec:
+  hosts:
+  - bookinfo.example.com
+  http:
+  - directResponse:
+      status: 503
+    route:
+    - destination:
+        host: productpage
+`),
+		},
+		"No direct response": {
+			expected: false,
+			vsYAML: []byte(`
+apiVersion: networking.istio.io/v1beta1
+kind: VirtualService
+metadata:
+  name: productpage
+spec:
+  hosts:
+  - bookinfo.example.com
+  http:
+  - route:
+    - destination:
+        host: productpage
+`),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			var vs models.VirtualService
+			assert.NoError(yaml.Unmarshal(tc.vsYAML, &vs))
+
+			assert.Equal(tc.expected, vs.HasDirectResponse())
+		})
+	}
+
+	// Testing nil case
+	var vs *models.VirtualService
+	assert.False(t, vs.HasDirectResponse())
+}
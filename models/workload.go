@@ -1,6 +1,7 @@
 package models
 
 import (
+	"sort"
 	"strconv"
 
 	osapps_v1 "github.com/openshift/api/apps/v1"
@@ -79,6 +80,11 @@ type WorkloadListItem struct {
 	// example: 1
 	PodCount int `json:"podCount"`
 
+	// Number of available (ready) workload pods
+	// required: true
+	// example: 1
+	AvailablePodCount int `json:"availablePodCount"`
+
 	// HealthAnnotations
 	// required: false
 	HealthAnnotations map[string]string `json:"healthAnnotations"`
@@ -89,6 +95,10 @@ type WorkloadListItem struct {
 	// Dashboard annotations
 	// required: false
 	DashboardAnnotations map[string]string `json:"dashboardAnnotations"`
+
+	// Names of the service accounts the workload pods run as
+	// required: false
+	ServiceAccountNames []string `json:"serviceAccountNames"`
 }
 
 type WorkloadOverviews []*WorkloadListItem
@@ -134,8 +144,11 @@ func (workload *WorkloadListItem) ParseWorkload(w *Workload) {
 	workload.CreatedAt = w.CreatedAt
 	workload.ResourceVersion = w.ResourceVersion
 	workload.IstioSidecar = w.HasIstioSidecar()
+	workload.IstioInjectionAnnotation = w.IstioInjectionAnnotation
 	workload.Labels = w.Labels
 	workload.PodCount = len(w.Pods)
+	workload.ServiceAccountNames = w.Pods.ServiceAccounts()
+	workload.AvailablePodCount = int(w.AvailableReplicas)
 	workload.AdditionalDetailSample = w.AdditionalDetailSample
 	workload.HealthAnnotations = w.HealthAnnotations
 	workload.IstioReferences = []*IstioValidationKey{}
@@ -368,6 +381,38 @@ func (workload *Workload) HasIstioSidecar() bool {
 	return workload.Pods.HasIstioSidecar()
 }
 
+// IsSidecarInjected returns true when the workload pods run the istio-proxy container
+// or the workload is explicitly annotated for sidecar injection
+func (workload *WorkloadListItem) IsSidecarInjected() bool {
+	if workload.IstioSidecar {
+		return true
+	}
+	return workload.IstioInjectionAnnotation != nil && *workload.IstioInjectionAnnotation
+}
+
+// IsGateway returns true when the workload is an Istio ingress or egress gateway,
+// either labeled by the gateway charts or by the Istio operator
+func (workload *WorkloadListItem) IsGateway() bool {
+	switch workload.Labels["istio"] {
+	case "ingressgateway", "egressgateway":
+		return true
+	}
+	switch workload.Labels["operator.istio.io/component"] {
+	case "IngressGateways", "EgressGateways":
+		return true
+	}
+	return false
+}
+
+// IsWaypoint returns true when the workload is an ambient waypoint proxy, that is a Gateway API
+// gateway deployment managed by the Istio mesh controller
+func (workload *WorkloadListItem) IsWaypoint() bool {
+	if _, found := workload.Labels["gateway.networking.k8s.io/gateway-name"]; !found {
+		return false
+	}
+	return workload.Labels["gateway.istio.io/managed"] == "istio.io-mesh-controller"
+}
+
 // HasIstioSidecar returns true if there is at least one workload which has a sidecar
 func (workloads WorkloadOverviews) HasIstioSidecar() bool {
 	if len(workloads) > 0 {
@@ -387,3 +432,30 @@ func (wl WorkloadList) GetLabels() []labels.Set {
 	}
 	return wLabels
 }
+
+// GroupByAppVersion returns the sorted distinct versions found for each app of the list,
+// based on the configured app and version labels. Workloads without app label are ignored.
+func (wl WorkloadList) GroupByAppVersion() map[string][]string {
+	cfg := config.Get()
+	appVersions := make(map[string][]string)
+	for _, w := range wl.Workloads {
+		app, found := w.Labels[cfg.IstioLabels.AppLabelName]
+		if !found {
+			continue
+		}
+		versions := appVersions[app]
+		if versions == nil {
+			versions = []string{}
+		}
+		if version, found := w.Labels[cfg.IstioLabels.VersionLabelName]; found {
+			idx := sort.SearchStrings(versions, version)
+			if idx == len(versions) || versions[idx] != version {
+				versions = append(versions, "")
+				copy(versions[idx+1:], versions[idx:])
+				versions[idx] = version
+			}
+		}
+		appVersions[app] = versions
+	}
+	return appVersions
+}
@@ -49,3 +49,8 @@ func (we *WorkloadEntry) Parse(workloadEntry kubernetes.IstioObject) {
 	we.Spec.Weight = workloadEntry.GetSpec()["weight"]
 	we.Spec.ServiceAccount = workloadEntry.GetSpec()["serviceAccount"]
 }
+
+// ContentHash returns a hash of the WorkloadEntry spec. Identical specs produce identical hashes.
+func (we *WorkloadEntry) ContentHash() string {
+	return contentHash(we.Spec)
+}
@@ -43,3 +43,8 @@ func (wg *WorkloadGroup) Parse(workloadGroup kubernetes.IstioObject) {
 	wg.Spec.Template = workloadGroup.GetSpec()["template"]
 	wg.Spec.Probe = workloadGroup.GetSpec()["probe"]
 }
+
+// ContentHash returns a hash of the WorkloadGroup spec. Identical specs produce identical hashes.
+func (wg *WorkloadGroup) ContentHash() string {
+	return contentHash(wg.Spec)
+}
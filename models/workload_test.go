@@ -186,6 +186,74 @@ func TestParsePodWithoutLabelsToWorkload(t *testing.T) {
 	assert.Equal(map[string]string{}, w.Labels)
 }
 
+func TestGroupByAppVersion(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	wl := WorkloadList{
+		Workloads: []WorkloadListItem{
+			{Name: "reviews-v2", Labels: map[string]string{"app": "reviews", "version": "v2"}},
+			{Name: "reviews-v1", Labels: map[string]string{"app": "reviews", "version": "v1"}},
+			{Name: "reviews-v1-canary", Labels: map[string]string{"app": "reviews", "version": "v1"}},
+			{Name: "details", Labels: map[string]string{"app": "details"}},
+			{Name: "unlabeled", Labels: map[string]string{"foo": "bar"}},
+		},
+	}
+
+	groups := wl.GroupByAppVersion()
+	assert.Len(groups, 2)
+	assert.Equal([]string{"v1", "v2"}, groups["reviews"])
+	assert.Empty(groups["details"])
+}
+
+func TestIsSidecarInjected(t *testing.T) {
+	assert := assert.New(t)
+
+	injected := WorkloadListItem{Name: "reviews-v1", IstioSidecar: true}
+	assert.True(injected.IsSidecarInjected())
+
+	annotated := true
+	pendingInjection := WorkloadListItem{Name: "reviews-v2", IstioInjectionAnnotation: &annotated}
+	assert.True(pendingInjection.IsSidecarInjected())
+
+	notAnnotated := false
+	optedOut := WorkloadListItem{Name: "reviews-v3", IstioInjectionAnnotation: &notAnnotated}
+	assert.False(optedOut.IsSidecarInjected())
+
+	notInjected := WorkloadListItem{Name: "details-v1"}
+	assert.False(notInjected.IsSidecarInjected())
+}
+
+func TestWorkloadRoles(t *testing.T) {
+	assert := assert.New(t)
+
+	ingress := Workload{}
+	ingress.Name = "istio-ingressgateway"
+	ingress.Labels = map[string]string{"app": "istio-ingressgateway", "istio": "ingressgateway"}
+	assert.True(ingress.IsGateway())
+	assert.False(ingress.IsWaypoint())
+
+	operatorEgress := Workload{}
+	operatorEgress.Name = "egress-gw"
+	operatorEgress.Labels = map[string]string{"operator.istio.io/component": "EgressGateways"}
+	assert.True(operatorEgress.IsGateway())
+
+	waypoint := Workload{}
+	waypoint.Name = "bookinfo-waypoint"
+	waypoint.Labels = map[string]string{
+		"gateway.istio.io/managed":               "istio.io-mesh-controller",
+		"gateway.networking.k8s.io/gateway-name": "bookinfo-waypoint",
+	}
+	assert.True(waypoint.IsWaypoint())
+	assert.False(waypoint.IsGateway())
+
+	app := Workload{}
+	app.Name = "reviews-v1"
+	app.Labels = map[string]string{"app": "reviews", "version": "v1"}
+	assert.False(app.IsGateway())
+	assert.False(app.IsWaypoint())
+}
+
 func fakeDeployment() *apps_v1.Deployment {
 	t1, _ := time.Parse(time.RFC822Z, "08 Mar 18 17:44 +0300")
 	replicas := int32(1)
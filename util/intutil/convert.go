@@ -16,6 +16,9 @@ func Convert(subject interface{}) (int, error) {
 		result = int(typedSubject)
 	case int:
 		result = typedSubject
+	case float64:
+		// Numbers decoded from JSON into interface{} are float64
+		result = int(typedSubject)
 	default:
 		return 0, errors.New("it is not a numeric input")
 	}